@@ -0,0 +1,90 @@
+package elevenlabs
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// AgentConfigChange represents a setting of an agent whose deployed value differs from the desired one.
+type AgentConfigChange struct {
+	Path     string      // JSON path of the setting, e.g. "conversation_config.agent.prompt.llm"
+	Deployed interface{} // nil if the setting is not deployed
+	Desired  interface{}
+}
+
+func (c AgentConfigChange) String() string {
+	deployed, _ := json.Marshal(c.Deployed)
+	desired, _ := json.Marshal(c.Desired)
+	return fmt.Sprintf("%s: %s -> %s", c.Path, deployed, desired)
+}
+
+// DiffAgentConfig compares an agent definition kept in code with the one deployed, as returned by GetAgent in
+// Agent.AgentConfig.
+//
+// Only the settings set in desired are compared, since the server fills in defaults for the others. Lists, such
+// as tools or knowledge base documents, are compared element by element when their lengths match and as a
+// whole otherwise.
+//
+// It returns the settings that differ, ordered by path, or an error if either definition can't be encoded. An
+// empty result means that UpdateAgent doesn't need to be called.
+func DiffAgentConfig(deployed, desired AgentConfig) ([]AgentConfigChange, error) {
+	deployedValue, err := jsonValue(deployed)
+	if err != nil {
+		return nil, err
+	}
+	desiredValue, err := jsonValue(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []AgentConfigChange
+	diffJSONValues("", deployedValue, desiredValue, &changes)
+	return changes, nil
+}
+
+// jsonValue returns v as decoded by encoding/json into an empty interface, so that values of different Go types
+// can be compared field by field.
+func jsonValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	err = json.Unmarshal(b, &value)
+	return value, err
+}
+
+func diffJSONValues(path string, deployed, desired interface{}, changes *[]AgentConfigChange) {
+	if desiredList, ok := desired.([]interface{}); ok {
+		if deployedList, ok := deployed.([]interface{}); ok && len(deployedList) == len(desiredList) {
+			for i := range desiredList {
+				diffJSONValues(fmt.Sprintf("%s[%d]", path, i), deployedList[i], desiredList[i], changes)
+			}
+			return
+		}
+	}
+
+	desiredObj, ok := desired.(map[string]interface{})
+	if !ok {
+		if !reflect.DeepEqual(deployed, desired) {
+			*changes = append(*changes, AgentConfigChange{Path: path, Deployed: deployed, Desired: desired})
+		}
+		return
+	}
+
+	deployedObj, _ := deployed.(map[string]interface{})
+	keys := make([]string, 0, len(desiredObj))
+	for k := range desiredObj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		subPath := k
+		if path != "" {
+			subPath = path + "." + k
+		}
+		diffJSONValues(subPath, deployedObj[k], desiredObj[k], changes)
+	}
+}
@@ -0,0 +1,178 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func testAgentConfig() elevenlabs.AgentConfig {
+	temperature := float32(0.2)
+	return elevenlabs.AgentConfig{
+		Name: "Support",
+		ConversationConfig: elevenlabs.ConversationConfig{
+			Agent: &elevenlabs.AgentSettings{
+				FirstMessage: "Hi, how can I help?",
+				Language:     "en",
+				Prompt: &elevenlabs.AgentPrompt{
+					Prompt:      "You are a helpful support agent.",
+					LLM:         "gpt-4o-mini",
+					Temperature: &temperature,
+					Tools: []elevenlabs.AgentTool{{
+						Type:        elevenlabs.AgentToolTypeWebhook,
+						Name:        "get_order",
+						Description: "Looks up an order",
+						APISchema: &elevenlabs.WebhookToolAPISchema{
+							URL:    "https://example.com/orders",
+							Method: http.MethodPost,
+							RequestBodySchema: &elevenlabs.JSONSchema{
+								Type:       "object",
+								Properties: map[string]elevenlabs.JSONSchema{"order_id": {Type: "string"}},
+								Required:   []string{"order_id"},
+							},
+						},
+					}},
+					KnowledgeBase: []elevenlabs.KnowledgeBaseLocator{{Type: elevenlabs.KnowledgeBaseTypeURL, Name: "FAQ", ID: "kb1"}},
+				},
+			},
+			TTS: &elevenlabs.AgentTTSConfig{VoiceID: "v1"},
+		},
+	}
+}
+
+func TestAgents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "POST /convai/agents/create":
+			var config elevenlabs.AgentConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				t.Errorf("Server: failed to decode agent config: %v", err)
+				return
+			}
+			if diff, _ := elevenlabs.DiffAgentConfig(config, testAgentConfig()); len(diff) != 0 {
+				t.Errorf("Server: unexpected agent config changes %v", diff)
+			}
+			w.Write([]byte(`{"agent_id": "a1"}`))
+		case "GET /convai/agents/a1":
+			w.Write(testRespBodies["TestGetAgent"])
+		case "PATCH /convai/agents/a1":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["name"] != "Support v2" {
+				t.Errorf("Server: unexpected update %v", body)
+			}
+			w.Write([]byte(`{"agent_id": "a1", "name": "Support v2"}`))
+		case "POST /convai/agents/a1/duplicate":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["name"] != "Support copy" {
+				t.Errorf("Server: unexpected duplicate request %v", body)
+			}
+			w.Write([]byte(`{"agent_id": "a2"}`))
+		case "DELETE /convai/agents/a2":
+		case "GET /convai/agents":
+			switch r.URL.RawQuery {
+			case "search=Support":
+				w.Write([]byte(`{"agents": [{"agent_id": "a1", "name": "Support"}], "has_more": true, "next_cursor": "c1"}`))
+			case "cursor=c1&search=Support":
+				w.Write([]byte(`{"agents": [{"agent_id": "a2", "name": "Support copy"}], "has_more": false}`))
+			default:
+				t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+			}
+		default:
+			t.Errorf("Server: unexpected request %q", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	agentID, err := client.CreateAgent(testAgentConfig())
+	if err != nil || agentID != "a1" {
+		t.Fatalf("Unexpected result creating agent: %q, %v", agentID, err)
+	}
+
+	agent, err := client.GetAgent(agentID)
+	if err != nil {
+		t.Fatalf("Expected no errors getting agent, got error: %q", err)
+	}
+	if agent.AgentID != "a1" || agent.ConversationConfig.Agent.Prompt.LLM != "gpt-4o-mini" || agent.Metadata.CreatedAtUnixSecs != 1700000000 {
+		t.Errorf("Unexpected Agent: %+v", agent)
+	}
+
+	agent, err = client.UpdateAgent(agentID, elevenlabs.AgentConfig{Name: "Support v2"})
+	if err != nil || agent.Name != "Support v2" {
+		t.Errorf("Unexpected result updating agent: %+v, %v", agent, err)
+	}
+
+	copyID, err := client.DuplicateAgent(agentID, "Support copy")
+	if err != nil || copyID != "a2" {
+		t.Fatalf("Unexpected result duplicating agent: %q, %v", copyID, err)
+	}
+
+	var ids []string
+	agentsResp, nextPage, err := client.GetAgents(elevenlabs.Search("Support"))
+	for {
+		if err != nil {
+			t.Fatalf("Expected no errors listing agents, got error: %q", err)
+		}
+		for _, a := range agentsResp.Agents {
+			ids = append(ids, a.AgentID)
+		}
+		if nextPage == nil {
+			break
+		}
+		agentsResp, nextPage, err = nextPage()
+	}
+	if len(ids) != 2 || ids[0] != "a1" || ids[1] != "a2" {
+		t.Errorf("Expected agents [a1 a2], got %v", ids)
+	}
+
+	if err := client.DeleteAgent(copyID); err != nil {
+		t.Errorf("Expected no errors deleting agent, got error: %q", err)
+	}
+}
+
+func TestDiffAgentConfig(t *testing.T) {
+	var deployed elevenlabs.Agent
+	if err := json.Unmarshal(testRespBodies["TestGetAgent"], &deployed); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := elevenlabs.DiffAgentConfig(deployed.AgentConfig, testAgentConfig())
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Expected no changes against deployed agent, got %v", changes)
+	}
+
+	desired := testAgentConfig()
+	desired.ConversationConfig.Agent.Prompt.LLM = "gemini-2.0-flash"
+	desired.ConversationConfig.TTS.VoiceID = "v2"
+	desired.ConversationConfig.Turn = &elevenlabs.AgentTurnConfig{Mode: "silence"}
+	changes, err = elevenlabs.DiffAgentConfig(deployed.AgentConfig, desired)
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	expPaths := []string{"conversation_config.agent.prompt.llm", "conversation_config.tts.voice_id", "conversation_config.turn.mode"}
+	if len(changes) != len(expPaths) {
+		t.Fatalf("Expected changes to %v, got %v", expPaths, changes)
+	}
+	for i, p := range expPaths {
+		if changes[i].Path != p {
+			t.Errorf("Expected change %d to %q, got %v", i, p, changes[i])
+		}
+	}
+	if s := changes[0].String(); s != `conversation_config.agent.prompt.llm: "gpt-4o-mini" -> "gemini-2.0-flash"` {
+		t.Errorf("Unexpected change description %q", s)
+	}
+	if changes[2].Deployed != nil {
+		t.Errorf("Expected undeployed setting to be nil, got %v", changes[2].Deployed)
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 )
@@ -49,6 +52,18 @@ func WithRequestQueries(queries ...QueryFunc) RequestOption {
 	}
 }
 
+// requestOptions applies opts on top of the client defaults.
+func (c *Client) requestOptions(opts []RequestOption) RequestOptions {
+	options := RequestOptions{
+		ctx:     c.defaultCtx,
+		queries: []QueryFunc{},
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
 // Client represents an API client that can be used to make calls to the Elevenlabs API.
 // The NewClient function should be used when instantiating a new Client.
 //
@@ -106,6 +121,12 @@ func (c *Client) SetBaseURL(baseURL string) {
 }
 
 func (c *Client) doRequest(ctx context.Context, RespBodyWriter io.Writer, method, url string, bodyBuf io.Reader, contentType string, queries ...QueryFunc) error {
+	_, err := c.doRequestWithHeader(ctx, RespBodyWriter, method, url, bodyBuf, contentType, queries...)
+	return err
+}
+
+// doRequestWithHeader behaves like doRequest but also returns the headers of a successful response.
+func (c *Client) doRequestWithHeader(ctx context.Context, RespBodyWriter io.Writer, method, url string, bodyBuf io.Reader, contentType string, queries ...QueryFunc) (http.Header, error) {
 	if ctx == c.defaultCtx {
 		ctx_, cancel := context.WithTimeout(ctx, c.defaultTimeout)
 		ctx = ctx_
@@ -114,7 +135,7 @@ func (c *Client) doRequest(ctx context.Context, RespBodyWriter io.Writer, method
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bodyBuf)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	req.Header.Add("Accept", "*/*")
@@ -134,35 +155,40 @@ func (c *Client) doRequest(ctx context.Context, RespBodyWriter io.Writer, method
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		respBody, err := io.ReadAll(resp.Body)
-		if err != nil {
+		return nil, errorFromResponse(resp)
+	}
+
+	_, err = io.Copy(RespBodyWriter, resp.Body)
+	return resp.Header, err
+}
+
+// errorFromResponse decodes the body of a non-OK response into the matching error type.
+func errorFromResponse(resp *http.Response) error {
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized:
+		apiErr := &APIError{}
+		if err := json.Unmarshal(respBody, apiErr); err != nil {
 			return err
 		}
-		switch resp.StatusCode {
-		case http.StatusBadRequest, http.StatusUnauthorized:
-			apiErr := &APIError{}
-			if err := json.Unmarshal(respBody, apiErr); err != nil {
-				return err
-			}
-			return apiErr
-		case http.StatusUnprocessableEntity:
-			valErr := &ValidationError{}
-			if err := json.Unmarshal(respBody, valErr); err != nil {
-				return err
-			}
-			return valErr
-		default:
-			return fmt.Errorf("unexpected HTTP status \"%d %s\" returned from server", resp.StatusCode, http.StatusText(resp.StatusCode))
+		return apiErr
+	case http.StatusUnprocessableEntity:
+		valErr := &ValidationError{}
+		if err := json.Unmarshal(respBody, valErr); err != nil {
+			return err
 		}
+		return valErr
+	default:
+		return fmt.Errorf("unexpected HTTP status \"%d %s\" returned from server", resp.StatusCode, http.StatusText(resp.StatusCode))
 	}
-
-	_, err = io.Copy(RespBodyWriter, resp.Body)
-	return err
 }
 
 // LatencyOptimizations returns a QueryFunc that sets the http query 'optimize_streaming_latency' to
@@ -212,7 +238,8 @@ func WithSettings() QueryFunc {
 }
 
 // PageSize returns a QueryFunc that sets the http query 'page_size' to a given value. It is meant to be used
-// with GetHistory to set the number of elements returned in the GetHistoryResponse.History slice.
+// with GetHistory to set the number of elements returned in the GetHistoryResponse.History slice, and likewise
+// with the other paginated list methods.
 func PageSize(n int) QueryFunc {
 	return func(q *url.Values) {
 		q.Add("page_size", fmt.Sprint(n))
@@ -285,7 +312,8 @@ func (c *Client) GetModels() ([]Model, error) {
 	return models, nil
 }
 
-// GetVoices retrieves the list of all voices available for use.
+// GetVoices retrieves the list of all voices available for use. SearchVoices and IterateVoices retrieve them in
+// pages, which is preferable for accounts with many voices.
 //
 // It returns a slice of Voice objects or an error.
 func (c *Client) GetVoices() ([]Voice, error) {
@@ -303,6 +331,61 @@ func (c *Client) GetVoices() ([]Voice, error) {
 	return voiceResp.Voices, nil
 }
 
+// NextVoicesPageFunc represent functions that can be used to access subsequent pages of voices. It is returned by
+// the SearchVoices client method and behaves like NextHistoryPageFunc.
+type NextVoicesPageFunc func(...QueryFunc) (SearchVoicesResponse, NextVoicesPageFunc, error)
+
+// SearchVoices retrieves a page of the voices available for use that match a filter. Unlike GetVoices, the
+// voices are filtered and sorted by the server and returned in pages.
+//
+// It takes a VoiceSearchFilter argument that contains the search criteria and an optional list of QueryFunc
+// 'queries' to modify the request. The QueryFunc relevant for this function is PageSize. IterateVoices offers a
+// simpler way to walk them.
+//
+// It returns a SearchVoicesResponse object containing the voices, a function of type NextVoicesPageFunc to
+// retrieve the next page of voices, and an error.
+func (c *Client) SearchVoices(filter VoiceSearchFilter, queries ...QueryFunc) (SearchVoicesResponse, NextVoicesPageFunc, error) {
+	return c.searchVoicesPages(nextPageQueries(filter.queries(), queries...))
+}
+
+func (c *Client) searchVoicesPages(queries []QueryFunc) (SearchVoicesResponse, NextVoicesPageFunc, error) {
+	voicesResp, err := c.searchVoices(c.defaultCtx, queries)
+	if err != nil {
+		return SearchVoicesResponse{}, nil, err
+	}
+
+	if !voicesResp.HasMore {
+		return voicesResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (SearchVoicesResponse, NextVoicesPageFunc, error) {
+		return c.searchVoicesPages(nextPageQueries(queries, append(qf, nextPageToken(voicesResp.NextPageToken))...))
+	}
+	return voicesResp, nextPageFunc, nil
+}
+
+func (c *Client) searchVoices(ctx context.Context, queries []QueryFunc) (SearchVoicesResponse, error) {
+	b := bytes.Buffer{}
+	// The paginated search is only available in the second version of the API.
+	err := c.doRequest(ctx, &b, http.MethodGet, strings.TrimSuffix(c.baseURL, "/v1")+"/v2/voices", &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return SearchVoicesResponse{}, err
+	}
+
+	var voicesResp SearchVoicesResponse
+	if err := json.Unmarshal(b.Bytes(), &voicesResp); err != nil {
+		return SearchVoicesResponse{}, err
+	}
+
+	return voicesResp, nil
+}
+
+func nextPageToken(token string) QueryFunc {
+	return func(q *url.Values) {
+		q.Set("next_page_token", token)
+	}
+}
+
 // GetDefaultVoiceSettings retrieves the default settings for voices
 //
 // It returns a VoiceSettings object or an error.
@@ -349,9 +432,13 @@ func (c *Client) GetVoiceSettings(voiceId string) (VoiceSettings, error) {
 //
 // It returns a Voice object or an error.
 func (c *Client) GetVoice(voiceId string, queries ...QueryFunc) (Voice, error) {
+	return c.getVoice(c.defaultCtx, voiceId, queries)
+}
+
+func (c *Client) getVoice(ctx context.Context, voiceId string, queries []QueryFunc) (Voice, error) {
 	var voice Voice
 	b := bytes.Buffer{}
-	err := c.doRequest(c.defaultCtx, &b, http.MethodGet, fmt.Sprintf("%s/voices/%s", c.baseURL, voiceId), &bytes.Buffer{}, contentTypeJSON, queries...)
+	err := c.doRequest(ctx, &b, http.MethodGet, fmt.Sprintf("%s/voices/%s", c.baseURL, voiceId), &bytes.Buffer{}, contentTypeJSON, queries...)
 	if err != nil {
 		return Voice{}, err
 	}
@@ -618,13 +705,7 @@ func EnableLogging(value bool) QueryFunc {
 // MultichannelSpeechToTextResponse, or SpeechToTextWebhookResponse depending on the request parameters),
 // or an error.
 func (c *Client) SpeechToText(req SpeechToTextRequest, opts ...RequestOption) (interface{}, error) {
-	options := RequestOptions{
-		ctx:     c.defaultCtx,
-		queries: []QueryFunc{},
-	}
-	for _, opt := range opts {
-		opt(&options)
-	}
+	options := c.requestOptions(opts)
 
 	reqBodyBuf, contentType, err := req.buildRequestBody()
 	if err != nil {
@@ -673,3 +754,1706 @@ func (c *Client) SpeechToText(req SpeechToTextRequest, opts ...RequestOption) (i
 	}
 	return singleResp, nil
 }
+
+// ForcedAlignment aligns a known transcript with an audio or video file, returning the timing of each
+// character and word.
+//
+// It takes a ForcedAlignmentRequest argument that contains the audio file and the transcript to align,
+// and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns a ForcedAlignmentResponse object or an error.
+func (c *Client) ForcedAlignment(req ForcedAlignmentRequest, opts ...RequestOption) (ForcedAlignmentResponse, error) {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := req.buildRequestBody()
+	if err != nil {
+		return ForcedAlignmentResponse{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/forced-alignment", c.baseURL), reqBodyBuf, contentType, options.queries...)
+	if err != nil {
+		return ForcedAlignmentResponse{}, err
+	}
+
+	var alignment ForcedAlignmentResponse
+	if err := json.Unmarshal(b.Bytes(), &alignment); err != nil {
+		return ForcedAlignmentResponse{}, err
+	}
+
+	return alignment, nil
+}
+
+// GenerateSoundEffect converts a text prompt into a sound effect.
+//
+// It takes a SoundEffectRequest argument that contains the prompt alongside other settings and an optional
+// list of RequestOption 'opts' to modify the request. The QueryFunc relevant for this method is OutputFormat.
+//
+// It returns a byte slice that contains the audio data and the ResponseMetadata of the request, or an error.
+func (c *Client) GenerateSoundEffect(sfxReq SoundEffectRequest, opts ...RequestOption) ([]byte, ResponseMetadata, error) {
+	b := bytes.Buffer{}
+	meta, err := c.GenerateSoundEffectStream(&b, sfxReq, opts...)
+	if err != nil {
+		return nil, ResponseMetadata{}, err
+	}
+	return b.Bytes(), meta, nil
+}
+
+// GenerateSoundEffectStream converts a text prompt into a sound effect and streams the audio.
+//
+// It takes an io.Writer argument to which the streamed audio will be copied, a SoundEffectRequest argument that
+// contains the prompt alongside other settings and an optional list of RequestOption 'opts' to modify the request.
+// The QueryFunc relevant for this method is OutputFormat.
+//
+// It returns the ResponseMetadata of the request, or an error.
+func (c *Client) GenerateSoundEffectStream(streamWriter io.Writer, sfxReq SoundEffectRequest, opts ...RequestOption) (ResponseMetadata, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(sfxReq)
+	if err != nil {
+		return ResponseMetadata{}, err
+	}
+
+	header, err := c.doRequestWithHeader(options.ctx, streamWriter, http.MethodPost, fmt.Sprintf("%s/sound-generation", c.baseURL), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return ResponseMetadata{}, err
+	}
+	return responseMetadataFromHeader(header), nil
+}
+
+// SpeechToSpeech converts recorded speech to speech in a different voice, keeping the delivery of the original.
+//
+// It takes a string argument that represents the ID of the target voice, a SpeechToSpeechRequest argument that
+// contains the source audio alongside other settings and an optional list of RequestOption 'opts' to modify the
+// request. The QueryFunc functions relevant for this method are LatencyOptimizations, OutputFormat and EnableLogging.
+//
+// It returns a byte slice that contains the converted audio data in case of success, or an error.
+func (c *Client) SpeechToSpeech(voiceID string, stsReq SpeechToSpeechRequest, opts ...RequestOption) ([]byte, error) {
+	b := bytes.Buffer{}
+	if err := c.speechToSpeech(&b, fmt.Sprintf("%s/speech-to-speech/%s", c.baseURL, voiceID), stsReq, opts); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// SpeechToSpeechStream converts recorded speech to speech in a different voice and streams the converted audio.
+//
+// It takes an io.Writer argument to which the streamed audio will be copied, a string argument that represents
+// the ID of the target voice, a SpeechToSpeechRequest argument that contains the source audio alongside other
+// settings and an optional list of RequestOption 'opts' to modify the request. The QueryFunc functions relevant
+// for this method are LatencyOptimizations, OutputFormat and EnableLogging.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) SpeechToSpeechStream(streamWriter io.Writer, voiceID string, stsReq SpeechToSpeechRequest, opts ...RequestOption) error {
+	return c.speechToSpeech(streamWriter, fmt.Sprintf("%s/speech-to-speech/%s/stream", c.baseURL, voiceID), stsReq, opts)
+}
+
+func (c *Client) speechToSpeech(w io.Writer, url string, stsReq SpeechToSpeechRequest, opts []RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := stsReq.buildRequestBody()
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, w, http.MethodPost, url, reqBodyBuf, contentType, options.queries...)
+}
+
+// AudioIsolation removes background noise from audio, keeping only the vocals.
+//
+// It takes an AudioIsolationRequest argument that contains the audio to be processed and an optional list
+// of RequestOption 'opts' to modify the request.
+//
+// It returns a byte slice that contains the isolated audio data in case of success, or an error.
+func (c *Client) AudioIsolation(isoReq AudioIsolationRequest, opts ...RequestOption) ([]byte, error) {
+	b := bytes.Buffer{}
+	if err := c.audioIsolation(&b, fmt.Sprintf("%s/audio-isolation", c.baseURL), isoReq, opts); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// AudioIsolationStream removes background noise from audio and streams the isolated audio.
+//
+// It takes an io.Writer argument to which the streamed audio will be copied, an AudioIsolationRequest argument
+// that contains the audio to be processed and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) AudioIsolationStream(streamWriter io.Writer, isoReq AudioIsolationRequest, opts ...RequestOption) error {
+	return c.audioIsolation(streamWriter, fmt.Sprintf("%s/audio-isolation/stream", c.baseURL), isoReq, opts)
+}
+
+func (c *Client) audioIsolation(w io.Writer, url string, isoReq AudioIsolationRequest, opts []RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := isoReq.buildRequestBody()
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, w, http.MethodPost, url, reqBodyBuf, contentType, options.queries...)
+}
+
+// AddVoiceWithIsolation adds a new voice to the user's VoiceLab after removing the background noise
+// from each of its samples with AudioIsolation.
+//
+// It takes an AddEditVoiceRequest argument that contains the information of the voice to be added and an
+// optional list of RequestOption 'opts' that apply to the audio isolation requests. The isolated samples
+// are written to a temporary directory that is removed before returning.
+//
+// It returns the ID of the newly added voice, or an error.
+func (c *Client) AddVoiceWithIsolation(voiceReq AddEditVoiceRequest, opts ...RequestOption) (string, error) {
+	dir, err := os.MkdirTemp("", "elevenlabs-isolated-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	isolatedPaths := make([]string, 0, len(voiceReq.FilePaths)+len(voiceReq.Files))
+	for _, path := range voiceReq.FilePaths {
+		// Each sample gets its own sub-directory so that samples sharing a base name don't collide.
+		isolatedPath, err := c.isolateFile(path, filepath.Join(dir, fmt.Sprint(len(isolatedPaths))), opts)
+		if err != nil {
+			return "", fmt.Errorf("failed to isolate audio of %q: %w", path, err)
+		}
+		isolatedPaths = append(isolatedPaths, isolatedPath)
+	}
+	for _, file := range voiceReq.Files {
+		if file.Content == nil {
+			return "", fmt.Errorf("sample %q has no content", file.FileName)
+		}
+		isolatedPath, err := c.isolate(file.Content, file.FileName, filepath.Join(dir, fmt.Sprint(len(isolatedPaths))), opts)
+		if err != nil {
+			return "", fmt.Errorf("failed to isolate audio of %q: %w", file.FileName, err)
+		}
+		isolatedPaths = append(isolatedPaths, isolatedPath)
+	}
+
+	voiceReq.FilePaths = isolatedPaths
+	voiceReq.Files = nil
+	return c.AddVoice(voiceReq)
+}
+
+// isolateFile runs AudioIsolation on the file at path and writes the result as an mp3 file in dir.
+func (c *Client) isolateFile(path, dir string, opts []RequestOption) (string, error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	return c.isolate(in, filepath.Base(path), dir, opts)
+}
+
+// isolate runs AudioIsolation on the audio read from in and writes the result as an mp3 file in dir.
+func (c *Client) isolate(in io.Reader, fileName, dir string, opts []RequestOption) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	base := filepath.Base(fileName)
+	outPath := filepath.Join(dir, strings.TrimSuffix(base, filepath.Ext(base))+".mp3")
+	out, err := os.Create(outPath)
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+
+	if err := c.AudioIsolationStream(out, AudioIsolationRequest{Audio: in, FileName: base}, opts...); err != nil {
+		return "", err
+	}
+	return outPath, out.Close()
+}
+
+// DesignVoice generates previews of voices matching a description, speaking a sample text.
+//
+// It takes a VoiceDesignRequest argument that contains the description of the voice alongside other settings and
+// an optional list of RequestOption 'opts' to modify the request. The QueryFunc relevant for this method is
+// OutputFormat.
+//
+// It returns a VoiceDesignResponse whose previews can be listened to and turned into a voice with
+// CreateVoiceFromPreview, or an error.
+func (c *Client) DesignVoice(designReq VoiceDesignRequest, opts ...RequestOption) (VoiceDesignResponse, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(designReq)
+	if err != nil {
+		return VoiceDesignResponse{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/text-to-voice/design", c.baseURL), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return VoiceDesignResponse{}, err
+	}
+
+	var resp VoiceDesignResponse
+	if err := json.Unmarshal(b.Bytes(), &resp); err != nil {
+		return VoiceDesignResponse{}, err
+	}
+	return resp, nil
+}
+
+// CreateVoiceFromPreview adds a voice generated by DesignVoice to the voices of the user.
+//
+// It takes a CreateVoiceFromPreviewRequest argument that contains the ID of the chosen preview and the details
+// of the voice, and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the Voice created or an error.
+func (c *Client) CreateVoiceFromPreview(voiceReq CreateVoiceFromPreviewRequest, opts ...RequestOption) (Voice, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(voiceReq)
+	if err != nil {
+		return Voice{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/text-to-voice", c.baseURL), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return Voice{}, err
+	}
+
+	var voice Voice
+	if err := json.Unmarshal(b.Bytes(), &voice); err != nil {
+		return Voice{}, err
+	}
+	return voice, nil
+}
+
+// NextSharedVoicesPageFunc represent functions that can be used to access subsequent pages of the voice library.
+// It is returned by the GetSharedVoices client method and behaves like NextHistoryPageFunc.
+type NextSharedVoicesPageFunc func(...QueryFunc) (GetSharedVoicesResponse, NextSharedVoicesPageFunc, error)
+
+// GetSharedVoices searches the voices shared by the community in the voice library.
+//
+// It takes a SharedVoicesFilter argument that contains the search criteria and an optional list of QueryFunc
+// 'queries' to modify the request. The QueryFunc relevant for this function is PageSize.
+//
+// It returns a GetSharedVoicesResponse object containing the voices found, a function of type
+// NextSharedVoicesPageFunc to retrieve the next page of voices, and an error.
+func (c *Client) GetSharedVoices(filter SharedVoicesFilter, queries ...QueryFunc) (GetSharedVoicesResponse, NextSharedVoicesPageFunc, error) {
+	return c.getSharedVoices(nextPageQueries(filter.queries(), queries...), 0)
+}
+
+func (c *Client) getSharedVoices(queries []QueryFunc, page int) (GetSharedVoicesResponse, NextSharedVoicesPageFunc, error) {
+	pageQueries := queries
+	if page > 0 {
+		pageQueries = nextPageQueries(queries, func(q *url.Values) { q.Set("page", fmt.Sprint(page)) })
+	}
+
+	b := bytes.Buffer{}
+	err := c.doRequest(c.defaultCtx, &b, http.MethodGet, fmt.Sprintf("%s/shared-voices", c.baseURL), &bytes.Buffer{}, contentTypeJSON, pageQueries...)
+	if err != nil {
+		return GetSharedVoicesResponse{}, nil, err
+	}
+
+	var voicesResp GetSharedVoicesResponse
+	if err := json.Unmarshal(b.Bytes(), &voicesResp); err != nil {
+		return GetSharedVoicesResponse{}, nil, err
+	}
+
+	if !voicesResp.HasMore {
+		return voicesResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetSharedVoicesResponse, NextSharedVoicesPageFunc, error) {
+		return c.getSharedVoices(nextPageQueries(queries, qf...), page+1)
+	}
+	return voicesResp, nextPageFunc, nil
+}
+
+// AddSharedVoice adds a voice of the voice library to the voices of the user.
+//
+// It takes a string argument that represents the public ID of the owner of the voice, found in
+// VoiceSharing.PublicOwnerId, a string argument that represents the ID of the voice, the name given to the voice
+// in the account of the user and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the ID of the added voice, to be used with the other voice methods, or an error.
+func (c *Client) AddSharedVoice(publicOwnerID, voiceID, newName string, opts ...RequestOption) (string, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(addSharedVoiceRequest{NewName: newName})
+	if err != nil {
+		return "", err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/voices/add/%s/%s", c.baseURL, publicOwnerID, voiceID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return "", err
+	}
+
+	var voiceResp AddVoiceResponse
+	if err := json.Unmarshal(b.Bytes(), &voiceResp); err != nil {
+		return "", err
+	}
+	return voiceResp.VoiceId, nil
+}
+
+// CreatePVCVoice creates a professional voice clone, to which samples are then added. PVCWorkflow walks through
+// the whole process.
+//
+// It takes a CreatePVCVoiceRequest argument that contains the details of the voice and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns the ID of the new voice or an error.
+func (c *Client) CreatePVCVoice(voiceReq CreatePVCVoiceRequest, opts ...RequestOption) (string, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(voiceReq)
+	if err != nil {
+		return "", err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/voices/pvc", c.baseURL), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return "", err
+	}
+
+	var voiceResp AddVoiceResponse
+	if err := json.Unmarshal(b.Bytes(), &voiceResp); err != nil {
+		return "", err
+	}
+	return voiceResp.VoiceId, nil
+}
+
+// AddPVCSamples uploads audio samples to a professional voice clone.
+//
+// It takes a string argument that represents the ID of the voice, an AddPVCSamplesRequest argument that contains
+// the samples and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the uploaded PVCSample objects or an error.
+func (c *Client) AddPVCSamples(voiceID string, samplesReq AddPVCSamplesRequest, opts ...RequestOption) ([]PVCSample, error) {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := samplesReq.buildRequestBody()
+	if err != nil {
+		return nil, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/voices/pvc/%s/samples", c.baseURL, voiceID), reqBodyBuf, contentType, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []PVCSample
+	if err := json.Unmarshal(b.Bytes(), &samples); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+// UpdatePVCSample updates the speakers and the trimming of a sample of a professional voice clone.
+//
+// It takes the IDs of the voice and of the sample, an UpdatePVCSampleRequest argument that contains the changes
+// and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) UpdatePVCSample(voiceID, sampleID string, sampleReq UpdatePVCSampleRequest, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(sampleReq)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/voices/pvc/%s/samples/%s", c.baseURL, voiceID, sampleID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+}
+
+// StartSpeakerSeparation starts separating the speakers of a sample of a professional voice clone, so that only
+// the target speaker is used for training. Its progress is checked with GetSpeakerSeparation.
+//
+// It takes the IDs of the voice and of the sample, and an optional list of RequestOption 'opts' to modify the
+// request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) StartSpeakerSeparation(voiceID, sampleID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/voices/pvc/%s/samples/%s/separate-speakers", c.baseURL, voiceID, sampleID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// GetSpeakerSeparation retrieves the status and the result of the speaker separation of a sample.
+//
+// It takes the IDs of the voice and of the sample, and an optional list of RequestOption 'opts' to modify the
+// request.
+//
+// It returns a SpeakerSeparation object or an error.
+func (c *Client) GetSpeakerSeparation(voiceID, sampleID string, opts ...RequestOption) (SpeakerSeparation, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/voices/pvc/%s/samples/%s/speakers", c.baseURL, voiceID, sampleID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return SpeakerSeparation{}, err
+	}
+
+	var separation SpeakerSeparation
+	if err := json.Unmarshal(b.Bytes(), &separation); err != nil {
+		return SpeakerSeparation{}, err
+	}
+	return separation, nil
+}
+
+// GetPVCCaptcha retrieves the captcha of a professional voice clone: an image of the text the speaker must read
+// to prove their consent, recorded and uploaded with VerifyPVCCaptcha.
+//
+// It takes a string argument that represents the ID of the voice and an optional list of RequestOption 'opts' to
+// modify the request.
+//
+// It returns the captcha image or an error.
+func (c *Client) GetPVCCaptcha(voiceID string, opts ...RequestOption) ([]byte, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/voices/pvc/%s/captcha", c.baseURL, voiceID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// VerifyPVCCaptcha uploads the recording of the speaker reading the captcha of a professional voice clone.
+//
+// It takes a string argument that represents the ID of the voice, an io.Reader of the recording, its file name
+// and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if the voice was verified or an error otherwise.
+func (c *Client) VerifyPVCCaptcha(voiceID string, recording io.Reader, fileName string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	captchaReq := pvcCaptchaRequest{Recording: recording, FileName: fileName}
+	reqBodyBuf, contentType, err := captchaReq.buildRequestBody()
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/voices/pvc/%s/captcha", c.baseURL, voiceID), reqBodyBuf, contentType, options.queries...)
+}
+
+// RequestPVCVerification requests the manual verification of a professional voice clone by the ElevenLabs team.
+//
+// It takes a string argument that represents the ID of the voice, a PVCVerificationRequest argument that contains
+// the supporting documents and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) RequestPVCVerification(voiceID string, verificationReq PVCVerificationRequest, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := verificationReq.buildRequestBody()
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/voices/pvc/%s/verification", c.baseURL, voiceID), reqBodyBuf, contentType, options.queries...)
+}
+
+// TrainPVCVoice starts training a verified professional voice clone for a model. Its progress is reported by the
+// FineTuning.FineTuningState of the voice.
+//
+// It takes the ID of the voice, the ID of the model, which may be empty to use the default, and an optional list
+// of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) TrainPVCVoice(voiceID, modelID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(trainPVCVoiceRequest{ModelID: modelID})
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/voices/pvc/%s/train", c.baseURL, voiceID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+}
+
+// TranscriptFormat returns a QueryFunc that sets the http query 'format_type' to a certain value. It is meant to
+// be used with GetDubbingTranscript to choose between the "srt" (default) and "webvtt" subtitle formats.
+func TranscriptFormat(format string) QueryFunc {
+	return func(q *url.Values) {
+		q.Add("format_type", format)
+	}
+}
+
+// CreateDubbing starts dubbing a video or audio file, or the media found at a URL, into a target language.
+//
+// It takes a DubbingRequest argument that contains the source media and the dubbing settings, and an optional
+// list of RequestOption 'opts' to modify the request.
+//
+// It returns a DubbingJob that can be used to wait for the dub to complete and retrieve its results, or an error.
+func (c *Client) CreateDubbing(dubReq DubbingRequest, opts ...RequestOption) (*DubbingJob, error) {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := dubReq.buildRequestBody()
+	if err != nil {
+		return nil, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/dubbing", c.baseURL), reqBodyBuf, contentType, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	var dubResp CreateDubbingResponse
+	if err := json.Unmarshal(b.Bytes(), &dubResp); err != nil {
+		return nil, err
+	}
+
+	job := c.DubbingJobByID(dubResp.DubbingID)
+	job.ExpectedDurationSec = dubResp.ExpectedDurationSec
+	return job, nil
+}
+
+// GetDubbing retrieves the metadata of a dubbing project, including its status.
+//
+// It takes a string argument that represents the ID of the dubbing project and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns a DubbingMetadata object or an error.
+func (c *Client) GetDubbing(dubbingID string, opts ...RequestOption) (DubbingMetadata, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/dubbing/%s", c.baseURL, dubbingID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return DubbingMetadata{}, err
+	}
+
+	var meta DubbingMetadata
+	if err := json.Unmarshal(b.Bytes(), &meta); err != nil {
+		return DubbingMetadata{}, err
+	}
+
+	return meta, nil
+}
+
+// GetDubbedAudio streams the dubbed audio or video of a completed dubbing project for a given language.
+//
+// It takes an io.Writer argument to which the dubbed media will be copied, two string arguments representing
+// the ID of the dubbing project and the target language code respectively, and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) GetDubbedAudio(streamWriter io.Writer, dubbingID, languageCode string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, streamWriter, http.MethodGet, fmt.Sprintf("%s/dubbing/%s/audio/%s", c.baseURL, dubbingID, languageCode), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// GetDubbingTranscript retrieves the transcript of a dubbing project for a given language as subtitles.
+//
+// It takes two string arguments representing the ID of the dubbing project and the language code respectively,
+// and an optional list of RequestOption 'opts' to modify the request. The QueryFunc relevant for this method
+// is TranscriptFormat.
+//
+// It returns a byte slice containing the subtitles or an error.
+func (c *Client) GetDubbingTranscript(dubbingID, languageCode string, opts ...RequestOption) ([]byte, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/dubbing/%s/transcript/%s", c.baseURL, dubbingID, languageCode), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// GetDubbingResource retrieves the editable transcript of a dubbing project created with DubbingStudio set.
+//
+// It takes a string argument that represents the ID of the dubbing project and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns a DubbingResource object or an error.
+func (c *Client) GetDubbingResource(dubbingID string, opts ...RequestOption) (DubbingResource, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/dubbing/resource/%s", c.baseURL, dubbingID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return DubbingResource{}, err
+	}
+
+	var resource DubbingResource
+	if err := json.Unmarshal(b.Bytes(), &resource); err != nil {
+		return DubbingResource{}, err
+	}
+
+	return resource, nil
+}
+
+// EditDubbingSegment updates the timing or text of a segment of a dubbing project for a given language.
+//
+// It takes three string arguments representing the ID of the dubbing project, the ID of the segment and the
+// language code respectively, a DubbingSegmentUpdate argument that contains the changes and an optional list
+// of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) EditDubbingSegment(dubbingID, segmentID, languageCode string, update DubbingSegmentUpdate, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPatch, fmt.Sprintf("%s/dubbing/resource/%s/segment/%s/%s", c.baseURL, dubbingID, segmentID, languageCode), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+}
+
+// DeleteDubbing deletes a dubbing project.
+//
+// It takes a string argument that represents the ID of the dubbing project to be deleted and an optional list
+// of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DeleteDubbing(dubbingID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodDelete, fmt.Sprintf("%s/dubbing/%s", c.baseURL, dubbingID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// Cursor returns a QueryFunc that sets the http query 'cursor' to a given value. It is meant to be used with
+// cursor-paginated list methods such as GetProjects to specify the page to retrieve. The NextPageFunc returned
+// by these methods set it automatically.
+func Cursor(cursor string) QueryFunc {
+	return func(q *url.Values) {
+		q.Add("cursor", cursor)
+	}
+}
+
+// nextPageQueries returns a copy of queries with extra appended, so that the slices captured by successive
+// NextPageFunc closures never share a backing array.
+func nextPageQueries(queries []QueryFunc, extra ...QueryFunc) []QueryFunc {
+	qf := make([]QueryFunc, 0, len(queries)+len(extra))
+	qf = append(qf, queries...)
+	return append(qf, extra...)
+}
+
+// CreateProject creates a new Studio project from a document, a URL or plain text.
+//
+// It takes a CreateProjectRequest argument that contains the project content and settings, and an optional
+// list of RequestOption 'opts' to modify the request.
+//
+// It returns the newly created Project or an error.
+func (c *Client) CreateProject(projReq CreateProjectRequest, opts ...RequestOption) (Project, error) {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := projReq.buildRequestBody()
+	if err != nil {
+		return Project{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/studio/projects", c.baseURL), reqBodyBuf, contentType, options.queries...)
+	if err != nil {
+		return Project{}, err
+	}
+
+	var projResp struct {
+		Project Project `json:"project"`
+	}
+	if err := json.Unmarshal(b.Bytes(), &projResp); err != nil {
+		return Project{}, err
+	}
+
+	return projResp.Project, nil
+}
+
+// NextProjectsPageFunc represent functions that can be used to access subsequent pages of projects. It is
+// returned by the GetProjects client method and behaves like NextHistoryPageFunc.
+type NextProjectsPageFunc func(...QueryFunc) (GetProjectsResponse, NextProjectsPageFunc, error)
+
+// GetProjects retrieves the Studio projects of the user.
+//
+// It accepts an optional list of QueryFunc 'queries' to modify the request. The QueryFunc functions
+// relevant for this function are PageSize and Cursor.
+//
+// It returns a GetProjectsResponse object containing the projects, a function of type NextProjectsPageFunc
+// to retrieve the next page of projects, and an error.
+func (c *Client) GetProjects(queries ...QueryFunc) (GetProjectsResponse, NextProjectsPageFunc, error) {
+	var projResp GetProjectsResponse
+	b := bytes.Buffer{}
+	err := c.doRequest(c.defaultCtx, &b, http.MethodGet, fmt.Sprintf("%s/studio/projects", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return GetProjectsResponse{}, nil, err
+	}
+
+	if err := json.Unmarshal(b.Bytes(), &projResp); err != nil {
+		return GetProjectsResponse{}, nil, err
+	}
+
+	if !projResp.HasMore {
+		return projResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetProjectsResponse, NextProjectsPageFunc, error) {
+		return c.GetProjects(nextPageQueries(queries, append(qf, Cursor(projResp.NextCursor))...)...)
+	}
+	return projResp, nextPageFunc, nil
+}
+
+// GetProject retrieves a Studio project, including its chapters.
+//
+// It takes a string argument that represents the ID of the project and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns a Project object or an error.
+func (c *Client) GetProject(projectID string, opts ...RequestOption) (Project, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/studio/projects/%s", c.baseURL, projectID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return Project{}, err
+	}
+
+	var project Project
+	if err := json.Unmarshal(b.Bytes(), &project); err != nil {
+		return Project{}, err
+	}
+
+	return project, nil
+}
+
+// DeleteProject deletes a Studio project.
+//
+// It takes a string argument that represents the ID of the project to be deleted and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DeleteProject(projectID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodDelete, fmt.Sprintf("%s/studio/projects/%s", c.baseURL, projectID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// ConvertProject starts converting all the chapters of a Studio project to audio. WaitForProjectConversion can
+// be used to wait for the conversion to complete.
+//
+// It takes a string argument that represents the ID of the project and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) ConvertProject(projectID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/studio/projects/%s/convert", c.baseURL, projectID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// NextChaptersPageFunc represent functions that can be used to access subsequent pages of chapters. It is
+// returned by the GetChapters client method and behaves like NextHistoryPageFunc.
+type NextChaptersPageFunc func(...QueryFunc) (GetChaptersResponse, NextChaptersPageFunc, error)
+
+// GetChapters retrieves the chapters of a Studio project.
+//
+// It takes a string argument that represents the ID of the project and an optional list of QueryFunc 'queries'
+// to modify the request. The QueryFunc functions relevant for this function are PageSize and Cursor.
+//
+// It returns a GetChaptersResponse object containing the chapters, a function of type NextChaptersPageFunc
+// to retrieve the next page of chapters, and an error.
+func (c *Client) GetChapters(projectID string, queries ...QueryFunc) (GetChaptersResponse, NextChaptersPageFunc, error) {
+	var chapResp GetChaptersResponse
+	b := bytes.Buffer{}
+	err := c.doRequest(c.defaultCtx, &b, http.MethodGet, fmt.Sprintf("%s/studio/projects/%s/chapters", c.baseURL, projectID), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return GetChaptersResponse{}, nil, err
+	}
+
+	if err := json.Unmarshal(b.Bytes(), &chapResp); err != nil {
+		return GetChaptersResponse{}, nil, err
+	}
+
+	if !chapResp.HasMore {
+		return chapResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetChaptersResponse, NextChaptersPageFunc, error) {
+		return c.GetChapters(projectID, nextPageQueries(queries, append(qf, Cursor(chapResp.NextCursor))...)...)
+	}
+	return chapResp, nextPageFunc, nil
+}
+
+// GetChapter retrieves a chapter of a Studio project, including its content.
+//
+// It takes two string arguments representing the IDs of the project and the chapter respectively, and an
+// optional list of RequestOption 'opts' to modify the request.
+//
+// It returns a Chapter object or an error.
+func (c *Client) GetChapter(projectID, chapterID string, opts ...RequestOption) (Chapter, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/studio/projects/%s/chapters/%s", c.baseURL, projectID, chapterID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return Chapter{}, err
+	}
+
+	var chapter Chapter
+	if err := json.Unmarshal(b.Bytes(), &chapter); err != nil {
+		return Chapter{}, err
+	}
+
+	return chapter, nil
+}
+
+// EditChapter updates the name or content of a chapter of a Studio project.
+//
+// It takes two string arguments representing the IDs of the project and the chapter respectively, an
+// EditChapterRequest argument that contains the changes and an optional list of RequestOption 'opts' to
+// modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) EditChapter(projectID, chapterID string, chapReq EditChapterRequest, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(chapReq)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/studio/projects/%s/chapters/%s", c.baseURL, projectID, chapterID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+}
+
+// ConvertChapter starts converting a chapter of a Studio project to audio.
+//
+// It takes two string arguments representing the IDs of the project and the chapter respectively, and an
+// optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) ConvertChapter(projectID, chapterID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/studio/projects/%s/chapters/%s/convert", c.baseURL, projectID, chapterID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// GetProjectSnapshots retrieves the snapshots created by each conversion of a Studio project.
+//
+// It takes a string argument that represents the ID of the project and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns a slice of ProjectSnapshot objects or an error.
+func (c *Client) GetProjectSnapshots(projectID string, opts ...RequestOption) ([]ProjectSnapshot, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/studio/projects/%s/snapshots", c.baseURL, projectID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapResp GetProjectSnapshotsResponse
+	if err := json.Unmarshal(b.Bytes(), &snapResp); err != nil {
+		return nil, err
+	}
+
+	return snapResp.Snapshots, nil
+}
+
+// DownloadProjectSnapshot streams a zip archive containing the audio of every chapter of a project snapshot.
+//
+// It takes an io.Writer argument to which the archive will be copied, two string arguments representing the IDs
+// of the project and the snapshot respectively, and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DownloadProjectSnapshot(w io.Writer, projectID, snapshotID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, w, http.MethodPost, fmt.Sprintf("%s/studio/projects/%s/snapshots/%s/archive", c.baseURL, projectID, snapshotID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// GetChapterSnapshots retrieves the snapshots created by each conversion of a chapter.
+//
+// It takes two string arguments representing the IDs of the project and the chapter respectively, and an
+// optional list of RequestOption 'opts' to modify the request.
+//
+// It returns a slice of ChapterSnapshot objects or an error.
+func (c *Client) GetChapterSnapshots(projectID, chapterID string, opts ...RequestOption) ([]ChapterSnapshot, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/studio/projects/%s/chapters/%s/snapshots", c.baseURL, projectID, chapterID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapResp GetChapterSnapshotsResponse
+	if err := json.Unmarshal(b.Bytes(), &snapResp); err != nil {
+		return nil, err
+	}
+
+	return snapResp.Snapshots, nil
+}
+
+// DownloadChapterSnapshot streams the audio of a chapter snapshot.
+//
+// It takes an io.Writer argument to which the audio will be copied, three string arguments representing the IDs
+// of the project, the chapter and the snapshot respectively, and an optional list of RequestOption 'opts' to
+// modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DownloadChapterSnapshot(w io.Writer, projectID, chapterID, snapshotID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, w, http.MethodPost, fmt.Sprintf("%s/studio/projects/%s/chapters/%s/snapshots/%s/stream", c.baseURL, projectID, chapterID, snapshotID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// AddPronunciationDictionary adds a pronunciation dictionary from a PLS file.
+//
+// It takes an AddPronunciationDictionaryRequest argument that contains the PLS file and the dictionary details,
+// and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the PronunciationDictionaryVersion created, whose Locator can be used in a TextToSpeechRequest, or an error.
+func (c *Client) AddPronunciationDictionary(dictReq AddPronunciationDictionaryRequest, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := dictReq.buildRequestBody()
+	if err != nil {
+		return PronunciationDictionaryVersion{}, err
+	}
+
+	return c.pronunciationDictionaryVersionRequest(options, fmt.Sprintf("%s/pronunciation-dictionaries/add-from-file", c.baseURL), reqBodyBuf, contentType)
+}
+
+// AddPronunciationDictionaryFromRules adds a pronunciation dictionary from a list of rules.
+//
+// It takes an AddPronunciationDictionaryFromRulesRequest argument that contains the rules and the dictionary
+// details, and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the PronunciationDictionaryVersion created, whose Locator can be used in a TextToSpeechRequest, or an error.
+func (c *Client) AddPronunciationDictionaryFromRules(dictReq AddPronunciationDictionaryFromRulesRequest, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(dictReq)
+	if err != nil {
+		return PronunciationDictionaryVersion{}, err
+	}
+
+	return c.pronunciationDictionaryVersionRequest(options, fmt.Sprintf("%s/pronunciation-dictionaries/add-from-rules", c.baseURL), bytes.NewBuffer(reqBody), contentTypeJSON)
+}
+
+// AddPronunciationRules adds rules to a pronunciation dictionary, creating a new version of it. Existing rules
+// for the same strings are replaced.
+//
+// It takes a string argument that represents the ID of the dictionary, a slice of PronunciationRule to add and an
+// optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the new PronunciationDictionaryVersion or an error.
+func (c *Client) AddPronunciationRules(dictionaryID string, rules []PronunciationRule, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(addPronunciationRulesRequest{Rules: rules})
+	if err != nil {
+		return PronunciationDictionaryVersion{}, err
+	}
+
+	return c.pronunciationDictionaryVersionRequest(options, fmt.Sprintf("%s/pronunciation-dictionaries/%s/add-rules", c.baseURL, dictionaryID), bytes.NewBuffer(reqBody), contentTypeJSON)
+}
+
+// RemovePronunciationRules removes rules from a pronunciation dictionary, creating a new version of it.
+//
+// It takes a string argument that represents the ID of the dictionary, a slice of the strings whose rules are to be
+// removed and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the new PronunciationDictionaryVersion or an error.
+func (c *Client) RemovePronunciationRules(dictionaryID string, ruleStrings []string, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(removePronunciationRulesRequest{RuleStrings: ruleStrings})
+	if err != nil {
+		return PronunciationDictionaryVersion{}, err
+	}
+
+	return c.pronunciationDictionaryVersionRequest(options, fmt.Sprintf("%s/pronunciation-dictionaries/%s/remove-rules", c.baseURL, dictionaryID), bytes.NewBuffer(reqBody), contentTypeJSON)
+}
+
+func (c *Client) pronunciationDictionaryVersionRequest(options RequestOptions, url string, body io.Reader, contentType string) (PronunciationDictionaryVersion, error) {
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodPost, url, body, contentType, options.queries...)
+	if err != nil {
+		return PronunciationDictionaryVersion{}, err
+	}
+
+	var version PronunciationDictionaryVersion
+	if err := json.Unmarshal(b.Bytes(), &version); err != nil {
+		return PronunciationDictionaryVersion{}, err
+	}
+
+	return version, nil
+}
+
+// GetPronunciationDictionary retrieves the metadata of a pronunciation dictionary, including its latest version.
+//
+// It takes a string argument that represents the ID of the dictionary and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns a PronunciationDictionary object or an error.
+func (c *Client) GetPronunciationDictionary(dictionaryID string, opts ...RequestOption) (PronunciationDictionary, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/pronunciation-dictionaries/%s", c.baseURL, dictionaryID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return PronunciationDictionary{}, err
+	}
+
+	var dict PronunciationDictionary
+	if err := json.Unmarshal(b.Bytes(), &dict); err != nil {
+		return PronunciationDictionary{}, err
+	}
+
+	return dict, nil
+}
+
+// UpdatePronunciationDictionary updates the metadata of a pronunciation dictionary. Its rules are changed with
+// AddPronunciationRules and RemovePronunciationRules instead.
+//
+// It takes a string argument that represents the ID of the dictionary, an UpdatePronunciationDictionaryRequest
+// argument that contains the fields to update and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the updated PronunciationDictionary or an error.
+func (c *Client) UpdatePronunciationDictionary(dictionaryID string, dictReq UpdatePronunciationDictionaryRequest, opts ...RequestOption) (PronunciationDictionary, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(dictReq)
+	if err != nil {
+		return PronunciationDictionary{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPatch, fmt.Sprintf("%s/pronunciation-dictionaries/%s", c.baseURL, dictionaryID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return PronunciationDictionary{}, err
+	}
+
+	var dict PronunciationDictionary
+	if err := json.Unmarshal(b.Bytes(), &dict); err != nil {
+		return PronunciationDictionary{}, err
+	}
+
+	return dict, nil
+}
+
+// ArchivePronunciationDictionary archives a pronunciation dictionary, so that it is no longer listed by
+// GetPronunciationDictionaries.
+//
+// It takes a string argument that represents the ID of the dictionary and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns the archived PronunciationDictionary or an error.
+func (c *Client) ArchivePronunciationDictionary(dictionaryID string, opts ...RequestOption) (PronunciationDictionary, error) {
+	archived := true
+	return c.UpdatePronunciationDictionary(dictionaryID, UpdatePronunciationDictionaryRequest{Archived: &archived}, opts...)
+}
+
+// GetPronunciationDictionaryVersions retrieves the versions of a pronunciation dictionary, so that a previous
+// version can be downloaded or pinned with its Locator.
+//
+// It takes a string argument that represents the ID of the dictionary and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns a slice of PronunciationDictionaryVersion objects or an error.
+func (c *Client) GetPronunciationDictionaryVersions(dictionaryID string, opts ...RequestOption) ([]PronunciationDictionaryVersion, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/pronunciation-dictionaries/%s/versions", c.baseURL, dictionaryID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	var versionsResp getPronunciationDictionaryVersionsResponse
+	if err := json.Unmarshal(b.Bytes(), &versionsResp); err != nil {
+		return nil, err
+	}
+
+	for i := range versionsResp.Versions {
+		if versionsResp.Versions[i].ID == "" {
+			versionsResp.Versions[i].ID = dictionaryID
+		}
+	}
+	return versionsResp.Versions, nil
+}
+
+// NextPronunciationDictionariesPageFunc represent functions that can be used to access subsequent pages of
+// pronunciation dictionaries. It is returned by the GetPronunciationDictionaries client method and behaves
+// like NextHistoryPageFunc.
+type NextPronunciationDictionariesPageFunc func(...QueryFunc) (GetPronunciationDictionariesResponse, NextPronunciationDictionariesPageFunc, error)
+
+// GetPronunciationDictionaries retrieves the pronunciation dictionaries of the user.
+//
+// It accepts an optional list of QueryFunc 'queries' to modify the request. The QueryFunc functions
+// relevant for this function are PageSize and Cursor.
+//
+// It returns a GetPronunciationDictionariesResponse object containing the dictionaries, a function of type
+// NextPronunciationDictionariesPageFunc to retrieve the next page of dictionaries, and an error.
+func (c *Client) GetPronunciationDictionaries(queries ...QueryFunc) (GetPronunciationDictionariesResponse, NextPronunciationDictionariesPageFunc, error) {
+	var dictResp GetPronunciationDictionariesResponse
+	b := bytes.Buffer{}
+	err := c.doRequest(c.defaultCtx, &b, http.MethodGet, fmt.Sprintf("%s/pronunciation-dictionaries", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return GetPronunciationDictionariesResponse{}, nil, err
+	}
+
+	if err := json.Unmarshal(b.Bytes(), &dictResp); err != nil {
+		return GetPronunciationDictionariesResponse{}, nil, err
+	}
+
+	if !dictResp.HasMore {
+		return dictResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetPronunciationDictionariesResponse, NextPronunciationDictionariesPageFunc, error) {
+		return c.GetPronunciationDictionaries(nextPageQueries(queries, append(qf, Cursor(dictResp.NextCursor))...)...)
+	}
+	return dictResp, nextPageFunc, nil
+}
+
+// DownloadPronunciationDictionaryVersion streams the PLS file of a version of a pronunciation dictionary.
+//
+// It takes an io.Writer argument to which the PLS file will be copied, two string arguments representing the IDs
+// of the dictionary and the version respectively, and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DownloadPronunciationDictionaryVersion(w io.Writer, dictionaryID, versionID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, w, http.MethodGet, fmt.Sprintf("%s/pronunciation-dictionaries/%s/%s/download", c.baseURL, dictionaryID, versionID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// TextToDialogue converts a list of lines spoken by different voices into a single conversation.
+//
+// It takes a TextToDialogueRequest argument that contains the lines and their voices alongside other settings, and
+// an optional list of RequestOption 'opts' to modify the request. The QueryFunc relevant for this method is OutputFormat.
+//
+// It returns a byte slice that contains the audio data in case of success, or an error.
+func (c *Client) TextToDialogue(dialogueReq TextToDialogueRequest, opts ...RequestOption) ([]byte, error) {
+	b := bytes.Buffer{}
+	if err := c.textToDialogue(&b, fmt.Sprintf("%s/text-to-dialogue", c.baseURL), dialogueReq, opts); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// TextToDialogueStream converts a list of lines spoken by different voices into a single conversation and
+// streams the audio.
+//
+// It takes an io.Writer argument to which the streamed audio will be copied, a TextToDialogueRequest argument that
+// contains the lines and their voices alongside other settings, and an optional list of RequestOption 'opts' to
+// modify the request. The QueryFunc relevant for this method is OutputFormat.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) TextToDialogueStream(streamWriter io.Writer, dialogueReq TextToDialogueRequest, opts ...RequestOption) error {
+	return c.textToDialogue(streamWriter, fmt.Sprintf("%s/text-to-dialogue/stream", c.baseURL), dialogueReq, opts)
+}
+
+// TextToDialogueWithTimestamps converts a list of lines spoken by different voices into a single conversation,
+// and returns the timing of each character and of each line in the audio.
+//
+// It takes a TextToDialogueRequest argument that contains the lines and their voices alongside other settings, and
+// an optional list of RequestOption 'opts' to modify the request. The QueryFunc relevant for this method is OutputFormat.
+//
+// It returns a TextToDialogueWithTimestampsResponse object or an error.
+func (c *Client) TextToDialogueWithTimestamps(dialogueReq TextToDialogueRequest, opts ...RequestOption) (TextToDialogueWithTimestampsResponse, error) {
+	b := bytes.Buffer{}
+	if err := c.textToDialogue(&b, fmt.Sprintf("%s/text-to-dialogue/with-timestamps", c.baseURL), dialogueReq, opts); err != nil {
+		return TextToDialogueWithTimestampsResponse{}, err
+	}
+
+	var dialogueResp TextToDialogueWithTimestampsResponse
+	if err := json.Unmarshal(b.Bytes(), &dialogueResp); err != nil {
+		return TextToDialogueWithTimestampsResponse{}, err
+	}
+
+	return dialogueResp, nil
+}
+
+func (c *Client) textToDialogue(w io.Writer, url string, dialogueReq TextToDialogueRequest, opts []RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(dialogueReq)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, w, http.MethodPost, url, bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+}
+
+// Search returns a QueryFunc that sets the http query 'search' to a given value. It is meant to be used with
+// list methods such as GetAgents to only retrieve the elements whose name matches the search string.
+func Search(s string) QueryFunc {
+	return func(q *url.Values) {
+		q.Add("search", s)
+	}
+}
+
+// CreateAgent creates a new conversational AI agent.
+//
+// It takes an AgentConfig argument that contains the definition of the agent, and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns the ID of the newly created agent or an error.
+func (c *Client) CreateAgent(config AgentConfig, opts ...RequestOption) (string, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return c.agentIDRequest(options, fmt.Sprintf("%s/convai/agents/create", c.baseURL), bytes.NewBuffer(reqBody))
+}
+
+// GetAgent retrieves a conversational AI agent, including its full configuration.
+//
+// It takes a string argument that represents the ID of the agent and an optional list of RequestOption 'opts'
+// to modify the request.
+//
+// It returns an Agent object or an error.
+func (c *Client) GetAgent(agentID string, opts ...RequestOption) (Agent, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/agents/%s", c.baseURL, agentID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return Agent{}, err
+	}
+
+	var agent Agent
+	if err := json.Unmarshal(b.Bytes(), &agent); err != nil {
+		return Agent{}, err
+	}
+
+	return agent, nil
+}
+
+// UpdateAgent updates the configuration of a conversational AI agent. Only the fields set in config are changed.
+//
+// It takes a string argument that represents the ID of the agent, an AgentConfig argument that contains the
+// changes and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the updated Agent or an error.
+func (c *Client) UpdateAgent(agentID string, config AgentConfig, opts ...RequestOption) (Agent, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(config)
+	if err != nil {
+		return Agent{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPatch, fmt.Sprintf("%s/convai/agents/%s", c.baseURL, agentID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return Agent{}, err
+	}
+
+	var agent Agent
+	if err := json.Unmarshal(b.Bytes(), &agent); err != nil {
+		return Agent{}, err
+	}
+
+	return agent, nil
+}
+
+// DeleteAgent deletes a conversational AI agent.
+//
+// It takes a string argument that represents the ID of the agent to be deleted and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DeleteAgent(agentID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodDelete, fmt.Sprintf("%s/convai/agents/%s", c.baseURL, agentID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// DuplicateAgent creates a copy of a conversational AI agent.
+//
+// It takes a string argument that represents the ID of the agent to be copied, a string argument that represents
+// the name of the copy (the server picks one if empty) and an optional list of RequestOption 'opts' to modify the
+// request.
+//
+// It returns the ID of the new agent or an error.
+func (c *Client) DuplicateAgent(agentID, name string, opts ...RequestOption) (string, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(duplicateAgentRequest{Name: name})
+	if err != nil {
+		return "", err
+	}
+
+	return c.agentIDRequest(options, fmt.Sprintf("%s/convai/agents/%s/duplicate", c.baseURL, agentID), bytes.NewBuffer(reqBody))
+}
+
+func (c *Client) agentIDRequest(options RequestOptions, url string, body io.Reader) (string, error) {
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodPost, url, body, contentTypeJSON, options.queries...)
+	if err != nil {
+		return "", err
+	}
+
+	var resp agentIDResponse
+	if err := json.Unmarshal(b.Bytes(), &resp); err != nil {
+		return "", err
+	}
+
+	return resp.AgentID, nil
+}
+
+// NextAgentsPageFunc represent functions that can be used to access subsequent pages of agents. It is returned
+// by the GetAgents client method and behaves like NextHistoryPageFunc.
+type NextAgentsPageFunc func(...QueryFunc) (GetAgentsResponse, NextAgentsPageFunc, error)
+
+// GetAgents retrieves the conversational AI agents of the user.
+//
+// It accepts an optional list of QueryFunc 'queries' to modify the request. The QueryFunc functions
+// relevant for this function are PageSize, Cursor and Search.
+//
+// It returns a GetAgentsResponse object containing the agents, a function of type NextAgentsPageFunc to
+// retrieve the next page of agents, and an error.
+func (c *Client) GetAgents(queries ...QueryFunc) (GetAgentsResponse, NextAgentsPageFunc, error) {
+	var agentsResp GetAgentsResponse
+	b := bytes.Buffer{}
+	err := c.doRequest(c.defaultCtx, &b, http.MethodGet, fmt.Sprintf("%s/convai/agents", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return GetAgentsResponse{}, nil, err
+	}
+
+	if err := json.Unmarshal(b.Bytes(), &agentsResp); err != nil {
+		return GetAgentsResponse{}, nil, err
+	}
+
+	if !agentsResp.HasMore {
+		return agentsResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetAgentsResponse, NextAgentsPageFunc, error) {
+		return c.GetAgents(nextPageQueries(queries, append(qf, Cursor(agentsResp.NextCursor))...)...)
+	}
+	return agentsResp, nextPageFunc, nil
+}
+
+// GetSignedURL mints a signed URL that lets a client without an API key, such as a browser, open a conversation
+// with a private agent over WebSocket. Signed URLs expire after 15 minutes.
+//
+// It takes a string argument that represents the ID of the agent and an optional list of RequestOption 'opts'
+// to modify the request.
+//
+// It returns the signed URL or an error.
+func (c *Client) GetSignedURL(agentID string, opts ...RequestOption) (string, error) {
+	options := c.requestOptions(opts)
+	queries := append([]QueryFunc{agentIDQuery(agentID)}, options.queries...)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/conversation/get-signed-url", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return "", err
+	}
+
+	var resp signedURLResponse
+	if err := json.Unmarshal(b.Bytes(), &resp); err != nil {
+		return "", err
+	}
+
+	return resp.SignedURL, nil
+}
+
+// GetConversationToken mints a token that lets a client without an API key, such as a browser, open a WebRTC
+// conversation with a private agent.
+//
+// It takes a string argument that represents the ID of the agent and an optional list of RequestOption 'opts'
+// to modify the request.
+//
+// It returns the conversation token or an error.
+func (c *Client) GetConversationToken(agentID string, opts ...RequestOption) (string, error) {
+	options := c.requestOptions(opts)
+	queries := append([]QueryFunc{agentIDQuery(agentID)}, options.queries...)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/conversation/token", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return "", err
+	}
+
+	var resp conversationTokenResponse
+	if err := json.Unmarshal(b.Bytes(), &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Token, nil
+}
+
+func agentIDQuery(agentID string) QueryFunc {
+	return func(q *url.Values) {
+		q.Add("agent_id", agentID)
+	}
+}
+
+// NextConversationsPageFunc represent functions that can be used to access subsequent pages of conversations. It
+// is returned by the GetConversations client method and behaves like NextHistoryPageFunc.
+type NextConversationsPageFunc func(...QueryFunc) (GetConversationsResponse, NextConversationsPageFunc, error)
+
+// GetConversations retrieves the conversations held with the agents of the user, most recent first.
+//
+// It accepts an optional list of QueryFunc 'queries' to modify the request. The QueryFunc functions relevant for
+// this function are PageSize and Cursor. IterateConversations offers a simpler way to walk and filter them.
+//
+// It returns a GetConversationsResponse object containing the conversations, a function of type
+// NextConversationsPageFunc to retrieve the next page of conversations, and an error.
+func (c *Client) GetConversations(queries ...QueryFunc) (GetConversationsResponse, NextConversationsPageFunc, error) {
+	convResp, err := c.getConversations(c.defaultCtx, queries)
+	if err != nil {
+		return GetConversationsResponse{}, nil, err
+	}
+
+	if !convResp.HasMore {
+		return convResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetConversationsResponse, NextConversationsPageFunc, error) {
+		return c.GetConversations(nextPageQueries(queries, append(qf, Cursor(convResp.NextCursor))...)...)
+	}
+	return convResp, nextPageFunc, nil
+}
+
+func (c *Client) getConversations(ctx context.Context, queries []QueryFunc) (GetConversationsResponse, error) {
+	b := bytes.Buffer{}
+	err := c.doRequest(ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/conversations", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return GetConversationsResponse{}, err
+	}
+
+	var convResp GetConversationsResponse
+	if err := json.Unmarshal(b.Bytes(), &convResp); err != nil {
+		return GetConversationsResponse{}, err
+	}
+
+	return convResp, nil
+}
+
+// GetConversation retrieves a conversation with its transcript, metadata and analysis.
+//
+// It takes a string argument that represents the ID of the conversation and an optional list of RequestOption
+// 'opts' to modify the request.
+//
+// It returns a ConversationDetails object or an error.
+func (c *Client) GetConversation(conversationID string, opts ...RequestOption) (ConversationDetails, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/conversations/%s", c.baseURL, conversationID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return ConversationDetails{}, err
+	}
+
+	var details ConversationDetails
+	if err := json.Unmarshal(b.Bytes(), &details); err != nil {
+		return ConversationDetails{}, err
+	}
+
+	return details, nil
+}
+
+// GetConversationAudio streams the recording of a conversation.
+//
+// It takes an io.Writer argument to which the audio will be copied, a string argument that represents the ID of
+// the conversation and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) GetConversationAudio(w io.Writer, conversationID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, w, http.MethodGet, fmt.Sprintf("%s/convai/conversations/%s/audio", c.baseURL, conversationID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// SendConversationFeedback rates a conversation.
+//
+// It takes a string argument that represents the ID of the conversation, a string argument that represents the
+// feedback (FeedbackLike or FeedbackDislike) and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) SendConversationFeedback(conversationID, feedback string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(conversationFeedbackRequest{Feedback: feedback})
+	if err != nil {
+		return err
+	}
+
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodPost, fmt.Sprintf("%s/convai/conversations/%s/feedback", c.baseURL, conversationID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+}
+
+// DeleteConversation deletes a conversation, including its transcript and recording.
+//
+// It takes a string argument that represents the ID of the conversation to be deleted and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DeleteConversation(conversationID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodDelete, fmt.Sprintf("%s/convai/conversations/%s", c.baseURL, conversationID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// AddKnowledgeBaseFile uploads a document to the knowledge base of the user's agents.
+//
+// It takes an AddKnowledgeBaseFileRequest argument that contains the document and its name, and an optional list
+// of RequestOption 'opts' to modify the request.
+//
+// It returns the created KnowledgeBaseDocument, whose Locator can be added to an AgentPrompt, or an error.
+func (c *Client) AddKnowledgeBaseFile(docReq AddKnowledgeBaseFileRequest, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	options := c.requestOptions(opts)
+
+	reqBodyBuf, contentType, err := docReq.buildRequestBody()
+	if err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+
+	return c.addKnowledgeBaseDocument(options, "file", reqBodyBuf, contentType, KnowledgeBaseTypeFile)
+}
+
+// AddKnowledgeBaseURL creates a knowledge base document from the content of a web page.
+//
+// It takes two string arguments representing the URL of the page and the name of the document (the server picks
+// one if empty), and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the created KnowledgeBaseDocument or an error.
+func (c *Client) AddKnowledgeBaseURL(docURL, name string, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(addKnowledgeBaseURLRequest{URL: docURL, Name: name})
+	if err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+
+	return c.addKnowledgeBaseDocument(options, "url", bytes.NewBuffer(reqBody), contentTypeJSON, KnowledgeBaseTypeURL)
+}
+
+// AddKnowledgeBaseText creates a knowledge base document from plain text.
+//
+// It takes two string arguments representing the text and the name of the document, and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns the created KnowledgeBaseDocument or an error.
+func (c *Client) AddKnowledgeBaseText(text, name string, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(addKnowledgeBaseTextRequest{Text: text, Name: name})
+	if err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+
+	return c.addKnowledgeBaseDocument(options, "text", bytes.NewBuffer(reqBody), contentTypeJSON, KnowledgeBaseTypeText)
+}
+
+func (c *Client) addKnowledgeBaseDocument(options RequestOptions, source string, body io.Reader, contentType, docType string) (KnowledgeBaseDocument, error) {
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/convai/knowledge-base/%s", c.baseURL, source), body, contentType, options.queries...)
+	if err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+
+	var doc KnowledgeBaseDocument
+	if err := json.Unmarshal(b.Bytes(), &doc); err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+	if doc.Type == "" {
+		doc.Type = docType
+	}
+
+	return doc, nil
+}
+
+// NextKnowledgeBaseDocumentsPageFunc represent functions that can be used to access subsequent pages of knowledge
+// base documents. It is returned by the GetKnowledgeBaseDocuments client method and behaves like
+// NextHistoryPageFunc.
+type NextKnowledgeBaseDocumentsPageFunc func(...QueryFunc) (GetKnowledgeBaseDocumentsResponse, NextKnowledgeBaseDocumentsPageFunc, error)
+
+// GetKnowledgeBaseDocuments retrieves the documents of the knowledge base.
+//
+// It accepts an optional list of QueryFunc 'queries' to modify the request. The QueryFunc functions relevant for
+// this function are PageSize, Cursor and Search.
+//
+// It returns a GetKnowledgeBaseDocumentsResponse object containing the documents, a function of type
+// NextKnowledgeBaseDocumentsPageFunc to retrieve the next page of documents, and an error.
+func (c *Client) GetKnowledgeBaseDocuments(queries ...QueryFunc) (GetKnowledgeBaseDocumentsResponse, NextKnowledgeBaseDocumentsPageFunc, error) {
+	docsResp, err := c.getKnowledgeBaseDocuments(c.defaultCtx, queries)
+	if err != nil {
+		return GetKnowledgeBaseDocumentsResponse{}, nil, err
+	}
+
+	if !docsResp.HasMore {
+		return docsResp, nil, nil
+	}
+
+	nextPageFunc := func(qf ...QueryFunc) (GetKnowledgeBaseDocumentsResponse, NextKnowledgeBaseDocumentsPageFunc, error) {
+		return c.GetKnowledgeBaseDocuments(nextPageQueries(queries, append(qf, Cursor(docsResp.NextCursor))...)...)
+	}
+	return docsResp, nextPageFunc, nil
+}
+
+func (c *Client) getKnowledgeBaseDocuments(ctx context.Context, queries []QueryFunc) (GetKnowledgeBaseDocumentsResponse, error) {
+	b := bytes.Buffer{}
+	err := c.doRequest(ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/knowledge-base", c.baseURL), &bytes.Buffer{}, contentTypeJSON, queries...)
+	if err != nil {
+		return GetKnowledgeBaseDocumentsResponse{}, err
+	}
+
+	var docsResp GetKnowledgeBaseDocumentsResponse
+	if err := json.Unmarshal(b.Bytes(), &docsResp); err != nil {
+		return GetKnowledgeBaseDocumentsResponse{}, err
+	}
+
+	return docsResp, nil
+}
+
+// GetKnowledgeBaseDocument retrieves a document of the knowledge base.
+//
+// It takes a string argument that represents the ID of the document and an optional list of RequestOption 'opts'
+// to modify the request.
+//
+// It returns a KnowledgeBaseDocument object or an error.
+func (c *Client) GetKnowledgeBaseDocument(documentID string, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	options := c.requestOptions(opts)
+
+	b := bytes.Buffer{}
+	err := c.doRequest(options.ctx, &b, http.MethodGet, fmt.Sprintf("%s/convai/knowledge-base/%s", c.baseURL, documentID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+	if err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+
+	var doc KnowledgeBaseDocument
+	if err := json.Unmarshal(b.Bytes(), &doc); err != nil {
+		return KnowledgeBaseDocument{}, err
+	}
+
+	return doc, nil
+}
+
+// DeleteKnowledgeBaseDocument deletes a document of the knowledge base. Documents used by agents can only be
+// deleted once they are removed from the agents' configuration.
+//
+// It takes a string argument that represents the ID of the document to be deleted and an optional list of
+// RequestOption 'opts' to modify the request.
+//
+// It returns nil if successful or an error otherwise.
+func (c *Client) DeleteKnowledgeBaseDocument(documentID string, opts ...RequestOption) error {
+	options := c.requestOptions(opts)
+	return c.doRequest(options.ctx, &bytes.Buffer{}, http.MethodDelete, fmt.Sprintf("%s/convai/knowledge-base/%s", c.baseURL, documentID), &bytes.Buffer{}, contentTypeJSON, options.queries...)
+}
+
+// ComputeRAGIndex starts indexing a knowledge base document for retrieval-augmented generation with an embedding
+// model. Calling it again returns the current state of the index, which WaitForRAGIndex relies on.
+//
+// It takes two string arguments representing the ID of the document and the embedding model (e.g.
+// RAGEmbeddingModelE5Mistral), and an optional list of RequestOption 'opts' to modify the request.
+//
+// It returns the RAGIndex of the document or an error.
+func (c *Client) ComputeRAGIndex(documentID, model string, opts ...RequestOption) (RAGIndex, error) {
+	options := c.requestOptions(opts)
+
+	reqBody, err := json.Marshal(computeRAGIndexRequest{Model: model})
+	if err != nil {
+		return RAGIndex{}, err
+	}
+
+	b := bytes.Buffer{}
+	err = c.doRequest(options.ctx, &b, http.MethodPost, fmt.Sprintf("%s/convai/knowledge-base/%s/rag-index", c.baseURL, documentID), bytes.NewBuffer(reqBody), contentTypeJSON, options.queries...)
+	if err != nil {
+		return RAGIndex{}, err
+	}
+
+	var index RAGIndex
+	if err := json.Unmarshal(b.Bytes(), &index); err != nil {
+		return RAGIndex{}, err
+	}
+
+	return index, nil
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"text/template"
 )
@@ -25,9 +26,15 @@ const (
 // Run 'go generate' after adding new methods with a '{{.ReceiverType}}' pointer receiver.
 
 package elevenlabs
-
-import "io"
-{{range .Functions}}
+{{if eq (len .Imports) 1}}
+import {{index .Imports 0}}
+{{else if .Imports}}
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{end}}{{range .Functions}}
 // {{.FuncIdent}} calls the {{.FuncIdent}} method on the default client.
 func {{.FuncIdent}}{{.FuncParams}}{{.FuncResults}} {
 	{{if .FuncResults}}return {{end}}{{.MethodReceiver}}.{{.FuncIdent}}{{.FuncArgs}}
@@ -40,6 +47,7 @@ var sourceFiles []string = []string{"client.go", "models.go", "errors.go"}
 type proxyFuncFile struct {
 	GeneratorPath string
 	ReceiverType  string
+	Imports       []string
 	Functions     []proxyFunc
 }
 
@@ -73,9 +81,19 @@ func generate(w io.Writer, pkgFiles map[string]*ast.File) (int, error) {
 		GeneratorPath: g,
 		ReceiverType:  receiverType,
 	}
-	for _, pf := range pkgFiles {
-		methods := ptrRcvMethods(pf, receiverType)
+	// Iterate over files in a stable order so that the generated file does not change between runs.
+	fileNames := make([]string, 0, len(pkgFiles))
+	for name := range pkgFiles {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+	imports := map[string]bool{}
+	for _, name := range fileNames {
+		methods := ptrRcvMethods(pkgFiles[name], receiverType)
 		for _, m := range methods {
+			for _, spec := range usedImports(pkgFiles[name], m.Type) {
+				imports[spec] = true
+			}
 			sFile.Functions = append(sFile.Functions, proxyFunc{
 				FuncIdent:      m.Name.Name,
 				FuncParams:     genTypedParams(m.Type.Params),
@@ -86,6 +104,10 @@ func generate(w io.Writer, pkgFiles map[string]*ast.File) (int, error) {
 		}
 		total += len(methods)
 	}
+	for spec := range imports {
+		sFile.Imports = append(sFile.Imports, spec)
+	}
+	sort.Strings(sFile.Imports)
 	t := template.Must(template.New("").Parse(genFileTemplate))
 	if err := t.Execute(w, sFile); err != nil {
 		return 0, err
@@ -129,6 +151,36 @@ func ptrRcvMethods(f *ast.File, receiverTypeStr string) []*ast.FuncDecl {
 	return methodDecls
 }
 
+// usedImports returns the import specs of the packages referenced by the given method signature,
+// resolved against the imports of the file the method is declared in.
+func usedImports(f *ast.File, funcType *ast.FuncType) []string {
+	var specs []string
+	ast.Inspect(funcType, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgIdent, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		for _, imp := range f.Imports {
+			path := strings.Trim(imp.Path.Value, `"`)
+			name := path[strings.LastIndex(path, "/")+1:]
+			spec := imp.Path.Value
+			if imp.Name != nil {
+				name = imp.Name.Name
+				spec = name + " " + spec
+			}
+			if name == pkgIdent.Name {
+				specs = append(specs, spec)
+			}
+		}
+		return false
+	})
+	return specs
+}
+
 func genTypedParams(fl *ast.FieldList) string {
 	if fl.List == nil {
 		return "()"
@@ -195,6 +247,12 @@ func exprToString(expr ast.Expr) string {
 		return fmt.Sprintf("func%s%s", genTypedParams(fieldType.Params), genFuncReturnTypes(fieldType.Results))
 	case *ast.SelectorExpr:
 		return fmt.Sprintf("%s.%s", fieldType.X, fieldType.Sel)
+	case *ast.MapType:
+		return fmt.Sprintf("map[%s]%s", exprToString(fieldType.Key), exprToString(fieldType.Value))
+	case *ast.InterfaceType:
+		if fieldType.Methods == nil || len(fieldType.Methods.List) == 0 {
+			return "interface{}"
+		}
 	}
 	return fmt.Sprintf("%s", expr)
 }
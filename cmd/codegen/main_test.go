@@ -136,6 +136,13 @@ func TestGenFuncFromMethod(t *testing.T) {
 			expArgsStr:   "(w, f)",
 			expResStr:    " (io.Reader, http.ResponseWriter)",
 		},
+		{
+			name:         "10. One `map[string]string` param, one empty interface return",
+			inSrc:        `func (b *Client) SampleMethod(m map[string]string) (interface{}, error) {}`,
+			expParamsStr: "(m map[string]string)",
+			expArgsStr:   "(m)",
+			expResStr:    " (interface{}, error)",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -165,3 +172,42 @@ func TestGenFuncFromMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateImports(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inSrc      string
+		expImports string
+	}{
+		{
+			name:       "1. No imports used",
+			inSrc:      "func (c *Client) SampleMethod(x int) error {}",
+			expImports: "package elevenlabs\n\n// SampleMethod",
+		},
+		{
+			name:       "2. One import used",
+			inSrc:      "import (\n\"io\"\n\"os\"\n)\nfunc (c *Client) SampleMethod(w io.Writer) error {}",
+			expImports: "package elevenlabs\n\nimport \"io\"\n\n// SampleMethod",
+		},
+		{
+			name:       "3. Several imports used, including an aliased one",
+			inSrc:      "import (\n\"io\"\nctx \"context\"\n\"net/http\"\n)\nfunc (c *Client) SampleMethod(c ctx.Context, w io.Writer) http.Handler {}",
+			expImports: "package elevenlabs\n\nimport (\n\t\"io\"\n\t\"net/http\"\n\tctx \"context\"\n)\n\n// SampleMethod",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := parser.ParseFile(token.NewFileSet(), "", packageDef+tc.inSrc, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := bytes.Buffer{}
+			if _, err := generate(&b, map[string]*ast.File{"testSrc": f}); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(b.String(), tc.expImports) {
+				t.Errorf("Expected generated code to contain:\n%s\nGot:\n%s", tc.expImports, b.String())
+			}
+		})
+	}
+}
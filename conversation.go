@@ -0,0 +1,388 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// ClientToolHandler represents functions that run a client tool called by an agent. The returned string is sent
+// back to the agent as the result of the call; a non-nil error is reported to the agent as a failed call.
+type ClientToolHandler func(ClientToolCallEvent) (string, error)
+
+// Conversation represents a realtime conversation with a conversational AI agent held over WebSocket.
+//
+// A Conversation is created with NewConversation. Handlers for the events of interest are registered with the
+// On* methods before calling Start, after which user audio is sent with SendAudio. Handlers are called one at a
+// time from a single goroutine, except client tool handlers which each run in their own goroutine so that a slow
+// tool doesn't hold up the agent audio. Pings are answered automatically.
+type Conversation struct {
+	client *Client
+	config ConversationSessionConfig
+
+	onMetadata       func(ConversationMetadata)
+	onAudio          func(AgentAudioEvent)
+	onUserTranscript func(UserTranscriptEvent)
+	onAgentResponse  func(AgentResponseEvent)
+	onCorrection     func(AgentResponseCorrectionEvent)
+	onInterruption   func(InterruptionEvent)
+	toolHandlers     map[string]ClientToolHandler
+
+	conn      *websocket.Conn
+	writeMu   sync.Mutex
+	done      chan struct{}
+	finished  chan struct{}
+	closeOnce sync.Once
+
+	mu            sync.Mutex
+	started       bool
+	metadata      ConversationMetadata
+	lastInterrupt int
+	err           error
+}
+
+type conversationInitiationData struct {
+	Type                       string                 `json:"type"`
+	ConversationConfigOverride *ConversationConfig    `json:"conversation_config_override,omitempty"`
+	DynamicVariables           map[string]interface{} `json:"dynamic_variables,omitempty"`
+}
+
+type conversationUserAudio struct {
+	UserAudioChunk string `json:"user_audio_chunk"`
+}
+
+type conversationClientMessage struct {
+	Type       string `json:"type"`
+	Text       string `json:"text,omitempty"`
+	EventID    int    `json:"event_id,omitempty"`
+	ToolCallID string `json:"tool_call_id,omitempty"`
+	Result     string `json:"result,omitempty"`
+	IsError    bool   `json:"is_error,omitempty"`
+}
+
+type conversationServerMessage struct {
+	Type                         string                       `json:"type"`
+	MetadataEvent                ConversationMetadata         `json:"conversation_initiation_metadata_event"`
+	AudioEvent                   conversationAudioEvent       `json:"audio_event"`
+	UserTranscriptionEvent       UserTranscriptEvent          `json:"user_transcription_event"`
+	AgentResponseEvent           AgentResponseEvent           `json:"agent_response_event"`
+	AgentResponseCorrectionEvent AgentResponseCorrectionEvent `json:"agent_response_correction_event"`
+	InterruptionEvent            InterruptionEvent            `json:"interruption_event"`
+	PingEvent                    conversationPingEvent        `json:"ping_event"`
+	ClientToolCall               ClientToolCallEvent          `json:"client_tool_call"`
+	Message                      string                       `json:"message"`
+}
+
+type conversationAudioEvent struct {
+	EventID     int    `json:"event_id"`
+	AudioBase64 string `json:"audio_base_64"`
+}
+
+type conversationPingEvent struct {
+	EventID int `json:"event_id"`
+	PingMs  int `json:"ping_ms"`
+}
+
+// NewConversation prepares a conversation with a conversational AI agent. No connection is made until Start is
+// called.
+//
+// It takes a ConversationSessionConfig argument that contains the ID of the agent and the overrides for this
+// conversation.
+//
+// It returns the Conversation, on which handlers can then be registered.
+func (c *Client) NewConversation(config ConversationSessionConfig) *Conversation {
+	return &Conversation{
+		client:       c,
+		config:       config,
+		toolHandlers: map[string]ClientToolHandler{},
+		done:         make(chan struct{}),
+		finished:     make(chan struct{}),
+	}
+}
+
+// OnConversationStarted registers the function called with the conversation details once it has started.
+func (cv *Conversation) OnConversationStarted(f func(ConversationMetadata)) { cv.onMetadata = f }
+
+// OnAudio registers the function called with each chunk of audio spoken by the agent.
+func (cv *Conversation) OnAudio(f func(AgentAudioEvent)) { cv.onAudio = f }
+
+// OnUserTranscript registers the function called with the transcript of each turn of the user.
+func (cv *Conversation) OnUserTranscript(f func(UserTranscriptEvent)) { cv.onUserTranscript = f }
+
+// OnAgentResponse registers the function called with the text of each response of the agent.
+func (cv *Conversation) OnAgentResponse(f func(AgentResponseEvent)) { cv.onAgentResponse = f }
+
+// OnAgentResponseCorrection registers the function called when a response of the agent was cut short.
+func (cv *Conversation) OnAgentResponseCorrection(f func(AgentResponseCorrectionEvent)) {
+	cv.onCorrection = f
+}
+
+// OnInterruption registers the function called when the user interrupts the agent. Playback of the agent audio
+// received so far should be stopped.
+func (cv *Conversation) OnInterruption(f func(InterruptionEvent)) { cv.onInterruption = f }
+
+// OnClientToolCall registers the handler of the client tool with the given name. Calls to tools without a
+// handler are answered with an error so that the agent doesn't wait for them.
+func (cv *Conversation) OnClientToolCall(toolName string, handler ClientToolHandler) {
+	cv.toolHandlers[toolName] = handler
+}
+
+// Start opens the WebSocket connection and starts the conversation.
+//
+// It accepts an optional list of RequestOption 'opts'. When a context is provided with WithRequestContext,
+// cancelling it ends the conversation. Otherwise the client's timeout only applies to opening the connection.
+//
+// A Conversation can only be started once, even if Start fails: a new one must be created to try again.
+//
+// It returns nil if successful, ErrConversationClosed if the conversation was already started or closed, or
+// another error otherwise.
+func (cv *Conversation) Start(opts ...RequestOption) error {
+	cv.mu.Lock()
+	started := cv.started
+	cv.started = true
+	cv.mu.Unlock()
+	if started {
+		return ErrConversationClosed
+	}
+	select {
+	case <-cv.done:
+		return ErrConversationClosed
+	default:
+	}
+
+	options := cv.client.requestOptions(opts)
+	queries := append([]QueryFunc{agentIDQuery(cv.config.AgentID)}, options.queries...)
+	conn, err := cv.client.dialWebSocket(options.ctx, "/convai/conversation", queries...)
+	if err != nil {
+		cv.setErr(err)
+		cv.Close()
+		return err
+	}
+
+	// The connection is only set once the conversation is initiated, so that nothing else is sent before. Close
+	// closes done before reading the connection under writeMu, so a conversation closed meanwhile is noticed here.
+	cv.writeMu.Lock()
+	select {
+	case <-cv.done:
+		err = ErrConversationClosed
+	default:
+		err = conn.WriteJSON(conversationInitiationData{
+			Type:                       "conversation_initiation_client_data",
+			ConversationConfigOverride: cv.config.ConfigOverride,
+			DynamicVariables:           cv.config.DynamicVariables,
+		})
+	}
+	if err == nil {
+		cv.conn = conn
+	}
+	cv.writeMu.Unlock()
+	if errors.Is(err, ErrConversationClosed) {
+		conn.Close()
+		return err
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to start conversation: %w", err)
+		cv.setErr(err)
+		conn.Close()
+		cv.Close()
+		return err
+	}
+
+	go cv.readLoop()
+	go cv.closeOnDone(options.ctx)
+	return nil
+}
+
+// Metadata returns the details of the conversation, or a zero value if it has not started yet.
+func (cv *Conversation) Metadata() ConversationMetadata {
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+	return cv.metadata
+}
+
+// SendAudio sends a chunk of user audio encoded in the user input audio format of the agent.
+func (cv *Conversation) SendAudio(chunk []byte) error {
+	return cv.writeJSON(conversationUserAudio{UserAudioChunk: base64.StdEncoding.EncodeToString(chunk)})
+}
+
+// SendUserMessage sends a text message on behalf of the user, to which the agent responds as if it was spoken.
+func (cv *Conversation) SendUserMessage(text string) error {
+	return cv.writeJSON(conversationClientMessage{Type: "user_message", Text: text})
+}
+
+// SendContextualUpdate sends information the agent should know about without responding to it, such as a user
+// action in the application.
+func (cv *Conversation) SendContextualUpdate(text string) error {
+	return cv.writeJSON(conversationClientMessage{Type: "contextual_update", Text: text})
+}
+
+// SendUserActivity signals that the user is active, preventing the agent from speaking for a short while.
+func (cv *Conversation) SendUserActivity() error {
+	return cv.writeJSON(conversationClientMessage{Type: "user_activity"})
+}
+
+func (cv *Conversation) writeJSON(v interface{}) error {
+	cv.writeMu.Lock()
+	defer cv.writeMu.Unlock()
+	if cv.conn == nil {
+		return ErrConversationNotStarted
+	}
+	return cv.conn.WriteJSON(v)
+}
+
+// Wait blocks until the conversation ends, either because the server closed it or because Close was called.
+//
+// It returns the error that ended the conversation, if any.
+func (cv *Conversation) Wait() error {
+	<-cv.finished
+	return cv.Err()
+}
+
+// Err returns the error that ended the conversation, if any.
+func (cv *Conversation) Err() error {
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+	return cv.err
+}
+
+// Close ends the conversation.
+func (cv *Conversation) Close() error {
+	var err error
+	cv.closeOnce.Do(func() {
+		close(cv.done)
+		cv.writeMu.Lock()
+		conn := cv.conn
+		if conn != nil {
+			_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		}
+		cv.writeMu.Unlock()
+		if conn == nil {
+			close(cv.finished)
+			return
+		}
+		err = conn.Close()
+	})
+	return err
+}
+
+func (cv *Conversation) closeOnDone(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		cv.setErr(ctx.Err())
+		cv.Close()
+	case <-cv.done:
+	}
+}
+
+func (cv *Conversation) setErr(err error) {
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+	if cv.err == nil {
+		cv.err = err
+	}
+}
+
+func (cv *Conversation) readLoop() {
+	defer close(cv.finished)
+	for {
+		_, data, err := cv.conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-cv.done:
+			default:
+				if !isExpectedClose(err) {
+					cv.setErr(err)
+				}
+				cv.Close()
+			}
+			return
+		}
+
+		var msg conversationServerMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			cv.setErr(err)
+			cv.Close()
+			return
+		}
+		if err := cv.dispatch(msg); err != nil {
+			cv.setErr(err)
+			cv.Close()
+			return
+		}
+	}
+}
+
+func (cv *Conversation) dispatch(msg conversationServerMessage) error {
+	switch msg.Type {
+	case "conversation_initiation_metadata":
+		cv.mu.Lock()
+		cv.metadata = msg.MetadataEvent
+		cv.mu.Unlock()
+		if cv.onMetadata != nil {
+			cv.onMetadata(msg.MetadataEvent)
+		}
+	case "audio":
+		cv.mu.Lock()
+		interrupted := msg.AudioEvent.EventID <= cv.lastInterrupt
+		cv.mu.Unlock()
+		if interrupted || cv.onAudio == nil {
+			return nil
+		}
+		audio, err := base64.StdEncoding.DecodeString(msg.AudioEvent.AudioBase64)
+		if err != nil {
+			return fmt.Errorf("failed to decode agent audio: %w", err)
+		}
+		cv.onAudio(AgentAudioEvent{EventID: msg.AudioEvent.EventID, Audio: audio})
+	case "user_transcript":
+		if cv.onUserTranscript != nil {
+			cv.onUserTranscript(msg.UserTranscriptionEvent)
+		}
+	case "agent_response":
+		if cv.onAgentResponse != nil {
+			cv.onAgentResponse(msg.AgentResponseEvent)
+		}
+	case "agent_response_correction":
+		if cv.onCorrection != nil {
+			cv.onCorrection(msg.AgentResponseCorrectionEvent)
+		}
+	case "interruption":
+		cv.mu.Lock()
+		cv.lastInterrupt = msg.InterruptionEvent.EventID
+		cv.mu.Unlock()
+		if cv.onInterruption != nil {
+			cv.onInterruption(msg.InterruptionEvent)
+		}
+	case "ping":
+		return cv.writeJSON(conversationClientMessage{Type: "pong", EventID: msg.PingEvent.EventID})
+	case "client_tool_call":
+		go cv.runClientTool(msg.ClientToolCall)
+	case "error":
+		return &WebSocketError{Type: msg.Type, Message: msg.Message}
+	}
+	return nil
+}
+
+func (cv *Conversation) runClientTool(call ClientToolCallEvent) {
+	result := conversationClientMessage{Type: "client_tool_result", ToolCallID: call.ToolCallID}
+	handler, ok := cv.toolHandlers[call.ToolName]
+	if !ok {
+		result.Result, result.IsError = fmt.Sprintf("unknown client tool %q", call.ToolName), true
+	} else if res, err := handler(call); err != nil {
+		result.Result, result.IsError = err.Error(), true
+	} else {
+		result.Result = res
+	}
+
+	if err := cv.writeJSON(result); err != nil && !errors.Is(err, websocket.ErrCloseSent) {
+		select {
+		case <-cv.done:
+		default:
+			cv.setErr(fmt.Errorf("failed to send result of client tool %q: %w", call.ToolName, err))
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package elevenlabs
+
+import "context"
+
+// ConversationIterator walks the conversations matching a ConversationFilter, fetching pages lazily.
+//
+//	it := client.IterateConversations(ctx, elevenlabs.ConversationFilter{AgentID: agentID})
+//	for it.Next() {
+//		summary := it.Conversation()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ConversationIterator struct {
+	pageIterator[ConversationSummary]
+}
+
+// Conversation returns the current conversation. It must only be called after Next returned true.
+func (it *ConversationIterator) Conversation() ConversationSummary {
+	return it.cur
+}
+
+// IterateConversations returns an iterator over the conversations matching filter, most recent first.
+//
+// It takes a context.Context argument that bounds the whole iteration, and a ConversationFilter argument. Pages
+// are only fetched as the iterator advances, so stopping early saves API calls.
+func (c *Client) IterateConversations(ctx context.Context, filter ConversationFilter) *ConversationIterator {
+	queries := filter.queries()
+	it := &ConversationIterator{}
+	it.ctx = ctx
+	it.fetch = func(ctx context.Context, cursor string) ([]ConversationSummary, string, error) {
+		pageQueries := queries
+		if cursor != "" {
+			pageQueries = nextPageQueries(queries, Cursor(cursor))
+		}
+		convResp, err := c.getConversations(ctx, pageQueries)
+		if err != nil {
+			return nil, "", err
+		}
+
+		conversations := convResp.Conversations
+		if filter.Status != "" {
+			conversations = conversations[:0:0]
+			for _, conv := range convResp.Conversations {
+				if conv.Status == filter.Status {
+					conversations = append(conversations, conv)
+				}
+			}
+		}
+		if !convResp.HasMore {
+			return conversations, "", nil
+		}
+		return conversations, convResp.NextCursor, nil
+	}
+	return it
+}
@@ -0,0 +1,131 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func testConversationsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "GET /convai/conversations":
+			switch r.URL.RawQuery {
+			case "agent_id=a1&call_start_after_unix=1700000000&call_successful=success&page_size=2":
+				w.Write([]byte(`{"conversations": [{"conversation_id": "c1", "status": "done"}, {"conversation_id": "c2", "status": "failed"}], "has_more": true, "next_cursor": "n1"}`))
+			case "agent_id=a1&call_start_after_unix=1700000000&call_successful=success&cursor=n1&page_size=2":
+				w.Write([]byte(`{"conversations": [{"conversation_id": "c3", "status": "done"}], "has_more": false}`))
+			default:
+				t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+			}
+		case "GET /convai/conversations/conv1":
+			w.Write(testRespBodies["TestGetConversation"])
+		case "GET /convai/conversations/conv1/audio":
+			w.Write([]byte("recording"))
+		case "POST /convai/conversations/conv1/feedback":
+			buf := bytes.Buffer{}
+			buf.ReadFrom(r.Body)
+			if buf.String() != `{"feedback":"like"}` {
+				t.Errorf("Server: unexpected feedback %s", buf.String())
+			}
+		case "DELETE /convai/conversations/conv1":
+		default:
+			t.Errorf("Server: unexpected request %q", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIterateConversations(t *testing.T) {
+	server := testConversationsServer(t)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	filter := elevenlabs.ConversationFilter{
+		AgentID:        "a1",
+		CallStartAfter: time.Unix(1700000000, 0),
+		CallSuccessful: elevenlabs.EvaluationSuccess,
+		Status:         elevenlabs.ConversationStatusDone,
+		PageSize:       2,
+	}
+	var ids []string
+	it := client.IterateConversations(context.Background(), filter)
+	for it.Next() {
+		ids = append(ids, it.Conversation().ConversationID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if !reflect.DeepEqual(ids, []string{"c1", "c3"}) {
+		t.Errorf("Expected conversations [c1 c3], got %v", ids)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = client.IterateConversations(ctx, filter)
+	if it.Next() || !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Expected cancelled iteration to stop with context.Canceled, got %v", it.Err())
+	}
+}
+
+func TestGetConversation(t *testing.T) {
+	server := testConversationsServer(t)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	conv, err := client.GetConversation("conv1")
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if conv.Analysis == nil || conv.Analysis.EvaluationCriteriaResults["resolved"].Result != elevenlabs.EvaluationSuccess ||
+		conv.Analysis.DataCollectionResults["order_id"].Value != "42" || conv.Transcript[2].ToolCalls[0].ToolName != "get_order" {
+		t.Errorf("Unexpected ConversationDetails: %+v", conv)
+	}
+
+	exp := []elevenlabs.SpeakerUtterance{
+		{SpeakerID: elevenlabs.ConversationRoleAgent, Text: "Hi, how can I help?", Start: 0, End: 3},
+		{SpeakerID: elevenlabs.ConversationRoleUser, Text: "Where is my order?", Start: 3, End: 6},
+		{SpeakerID: elevenlabs.ConversationRoleAgent, Text: "It ships tomorrow.", Start: 6, End: 9},
+	}
+	if utterances := conv.Utterances(); !reflect.DeepEqual(utterances, exp) {
+		t.Errorf("Expected utterances %+v, got %+v", exp, utterances)
+	}
+
+	w := bytes.Buffer{}
+	if err := client.GetConversationAudio(&w, "conv1"); err != nil || w.String() != "recording" {
+		t.Errorf("Unexpected recording %q, %v", w.String(), err)
+	}
+	if err := client.SendConversationFeedback("conv1", elevenlabs.FeedbackLike); err != nil {
+		t.Errorf("Expected no errors sending feedback, got error: %q", err)
+	}
+	if err := client.DeleteConversation("conv1"); err != nil {
+		t.Errorf("Expected no errors deleting, got error: %q", err)
+	}
+}
+
+func TestSpeechToTextUtterances(t *testing.T) {
+	s1, s2 := "speaker_1", "speaker_2"
+	resp := elevenlabs.SpeechToTextResponse{Words: []elevenlabs.SpeechToTextWord{
+		{Text: "Hello", Start: 0, End: 0.4, Type: "word", SpeakerID: &s1},
+		{Text: " ", Start: 0.4, End: 0.5, Type: "spacing", SpeakerID: &s1},
+		{Text: "there.", Start: 0.5, End: 0.9, Type: "word", SpeakerID: &s1},
+		{Text: " ", Start: 0.9, End: 1.2, Type: "spacing", SpeakerID: &s2},
+		{Text: "Hi!", Start: 1.2, End: 1.5, Type: "word", SpeakerID: &s2},
+	}}
+	exp := []elevenlabs.SpeakerUtterance{
+		{SpeakerID: s1, Text: "Hello there.", Start: 0, End: 0.9},
+		{SpeakerID: s2, Text: "Hi!", Start: 1.2, End: 1.5},
+	}
+	if utterances := resp.Utterances(); !reflect.DeepEqual(utterances, exp) {
+		t.Errorf("Expected utterances %+v, got %+v", exp, utterances)
+	}
+}
@@ -0,0 +1,190 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestConversation(t *testing.T) {
+	server := testWebSocketServer(t, "agent_id=a1", func(conn *websocket.Conn) {
+		var msg map[string]interface{}
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Errorf("Server: failed to read initiation data: %v", err)
+			return
+		}
+		override, _ := msg["conversation_config_override"].(map[string]interface{})
+		vars, _ := msg["dynamic_variables"].(map[string]interface{})
+		if msg["type"] != "conversation_initiation_client_data" || override["agent"] == nil || vars["user_name"] != "Ada" {
+			t.Errorf("Server: unexpected initiation data %v", msg)
+		}
+
+		conn.WriteJSON(map[string]interface{}{
+			"type": "conversation_initiation_metadata",
+			"conversation_initiation_metadata_event": map[string]string{
+				"conversation_id": "conv1", "agent_output_audio_format": "pcm_16000", "user_input_audio_format": "pcm_16000",
+			},
+		})
+
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Errorf("Server: failed to read user audio: %v", err)
+			return
+		}
+		if audio, _ := base64.StdEncoding.DecodeString(msg["user_audio_chunk"].(string)); string(audio) != "hello" {
+			t.Errorf("Server: unexpected user audio %v", msg)
+		}
+
+		conn.WriteJSON(map[string]interface{}{"type": "user_transcript", "user_transcription_event": map[string]string{"user_transcript": "hello"}})
+		conn.WriteJSON(map[string]interface{}{"type": "agent_response", "agent_response_event": map[string]string{"agent_response": "Hi Ada"}})
+		conn.WriteJSON(map[string]interface{}{"type": "audio", "audio_event": map[string]interface{}{"event_id": 1, "audio_base_64": "YWdlbnQ="}})
+		conn.WriteJSON(map[string]interface{}{"type": "interruption", "interruption_event": map[string]int{"event_id": 2}})
+		conn.WriteJSON(map[string]interface{}{"type": "audio", "audio_event": map[string]interface{}{"event_id": 2, "audio_base_64": "c3RhbGU="}})
+
+		conn.WriteJSON(map[string]interface{}{"type": "ping", "ping_event": map[string]int{"event_id": 3, "ping_ms": 20}})
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Errorf("Server: failed to read pong: %v", err)
+			return
+		}
+		if msg["type"] != "pong" || msg["event_id"] != float64(3) {
+			t.Errorf("Server: expected pong, got %v", msg)
+		}
+
+		results := map[string]map[string]interface{}{}
+		conn.WriteJSON(map[string]interface{}{"type": "client_tool_call", "client_tool_call": map[string]interface{}{
+			"tool_name": "get_time", "tool_call_id": "t1", "parameters": map[string]string{"zone": "UTC"},
+		}})
+		conn.WriteJSON(map[string]interface{}{"type": "client_tool_call", "client_tool_call": map[string]interface{}{
+			"tool_name": "missing", "tool_call_id": "t2", "parameters": map[string]string{},
+		}})
+		for i := 0; i < 2; i++ {
+			var result map[string]interface{}
+			if err := conn.ReadJSON(&result); err != nil {
+				t.Errorf("Server: failed to read tool result: %v", err)
+				return
+			}
+			results[result["tool_call_id"].(string)] = result
+		}
+		if r := results["t1"]; r["type"] != "client_tool_result" || r["result"] != "12:00 UTC" || r["is_error"] != nil {
+			t.Errorf("Server: unexpected tool result %v", r)
+		}
+		if r := results["t2"]; r["is_error"] != true {
+			t.Errorf("Server: expected error result for unknown tool, got %v", r)
+		}
+
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	conv := client.NewConversation(elevenlabs.ConversationSessionConfig{
+		AgentID:          "a1",
+		ConfigOverride:   &elevenlabs.ConversationConfig{Agent: &elevenlabs.AgentSettings{FirstMessage: "Hello Ada"}},
+		DynamicVariables: map[string]interface{}{"user_name": "Ada"},
+	})
+
+	started := make(chan elevenlabs.ConversationMetadata, 1)
+	var events []string
+	conv.OnConversationStarted(func(m elevenlabs.ConversationMetadata) { started <- m })
+	conv.OnUserTranscript(func(e elevenlabs.UserTranscriptEvent) { events = append(events, "user:"+e.UserTranscript) })
+	conv.OnAgentResponse(func(e elevenlabs.AgentResponseEvent) { events = append(events, "agent:"+e.AgentResponse) })
+	conv.OnAudio(func(e elevenlabs.AgentAudioEvent) { events = append(events, "audio:"+string(e.Audio)) })
+	conv.OnInterruption(func(e elevenlabs.InterruptionEvent) { events = append(events, "interruption") })
+	conv.OnClientToolCall("get_time", func(call elevenlabs.ClientToolCallEvent) (string, error) {
+		var params struct{ Zone string }
+		if err := json.Unmarshal(call.Parameters, &params); err != nil {
+			return "", err
+		}
+		return "12:00 " + params.Zone, nil
+	})
+
+	if err := conv.Start(); err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	defer conv.Close()
+
+	if m := <-started; m.ConversationID != "conv1" || conv.Metadata().UserInputAudioFormat != "pcm_16000" {
+		t.Errorf("Unexpected ConversationMetadata: %+v", m)
+	}
+	if err := conv.SendAudio([]byte("hello")); err != nil {
+		t.Fatalf("Expected no errors sending audio, got error: %q", err)
+	}
+	if err := conv.Wait(); err != nil {
+		t.Errorf("Expected conversation to end without errors, got error: %q", err)
+	}
+
+	exp := []string{"user:hello", "agent:Hi Ada", "audio:agent", "interruption"}
+	if len(events) != len(exp) {
+		t.Fatalf("Expected events %v, got %v", exp, events)
+	}
+	for i := range exp {
+		if events[i] != exp[i] {
+			t.Errorf("Expected events %v, got %v", exp, events)
+			break
+		}
+	}
+}
+
+func TestConversationError(t *testing.T) {
+	server := testWebSocketServer(t, "", func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]string{"type": "error", "message": "quota exceeded"})
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	conv := client.NewConversation(elevenlabs.ConversationSessionConfig{AgentID: "a1"})
+	if err := conv.Start(); err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	var wsErr *elevenlabs.WebSocketError
+	if err := conv.Wait(); !errors.As(err, &wsErr) || wsErr.Message != "quota exceeded" {
+		t.Errorf("Expected WebSocketError, got %v", err)
+	}
+}
+
+func TestConversationNotStarted(t *testing.T) {
+	client := elevenlabs.NewMockClient(context.Background(), "http://127.0.0.1:0", mockAPIKey, mockTimeout)
+	conv := client.NewConversation(elevenlabs.ConversationSessionConfig{AgentID: "a1"})
+	if err := conv.SendUserMessage("hello"); !errors.Is(err, elevenlabs.ErrConversationNotStarted) {
+		t.Errorf("Expected ErrConversationNotStarted before Start, got %v", err)
+	}
+
+	if err := conv.Start(); err == nil {
+		t.Fatalf("Expected error connecting to closed port")
+	}
+	if err := conv.SendAudio([]byte("audio")); !errors.Is(err, elevenlabs.ErrConversationNotStarted) {
+		t.Errorf("Expected ErrConversationNotStarted after failed Start, got %v", err)
+	}
+	if err := conv.Wait(); err == nil {
+		t.Errorf("Expected the connection error from Wait")
+	}
+
+	// A failed conversation can't be started again.
+	if err := conv.Start(); !errors.Is(err, elevenlabs.ErrConversationClosed) {
+		t.Errorf("Expected ErrConversationClosed starting again, got %v", err)
+	}
+}
+
+func TestConversationStartAfterClose(t *testing.T) {
+	server := testWebSocketServer(t, "", func(conn *websocket.Conn) {
+		t.Errorf("Server: unexpected connection")
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	conv := client.NewConversation(elevenlabs.ConversationSessionConfig{AgentID: "a1"})
+	conv.Close()
+	if err := conv.Start(); !errors.Is(err, elevenlabs.ErrConversationClosed) {
+		t.Errorf("Expected ErrConversationClosed, got %v", err)
+	}
+	if err := conv.Wait(); err != nil {
+		t.Errorf("Expected no errors from Wait, got error: %q", err)
+	}
+}
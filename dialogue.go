@@ -0,0 +1,107 @@
+package elevenlabs
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ParseScreenplay converts a script written in a simple screenplay format into dialogue inputs.
+//
+// Each line of the script starting with "SPEAKER:" begins a new input spoken by that speaker. Lines without a
+// known speaker label continue the previous input, and blank lines are ignored. Speaker names are matched
+// case-insensitively against the keys of voiceIDs, which maps each speaker to the ID of their voice.
+//
+// It returns the dialogue inputs, or an error if the script starts without a speaker or names a speaker that is
+// not in voiceIDs. A label is considered a speaker name when it is written in capitals, as is customary in scripts,
+// so that lines such as "Note: ..." are treated as text rather than reported as an unknown speaker.
+func ParseScreenplay(script string, voiceIDs map[string]string) ([]DialogueInput, error) {
+	voices := make(map[string]string, len(voiceIDs))
+	for speaker, voiceID := range voiceIDs {
+		voices[strings.ToUpper(strings.TrimSpace(speaker))] = voiceID
+	}
+
+	var inputs []DialogueInput
+	scanner := bufio.NewScanner(strings.NewReader(script))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if label, text, ok := strings.Cut(line, ":"); ok {
+			label = strings.TrimSpace(label)
+			if voiceID, known := voices[strings.ToUpper(label)]; known {
+				inputs = append(inputs, DialogueInput{Text: strings.TrimSpace(text), VoiceID: voiceID})
+				continue
+			}
+			if isSpeakerLabel(label) {
+				return nil, fmt.Errorf("line %d: unknown speaker %q", lineNum, label)
+			}
+		}
+
+		if len(inputs) == 0 {
+			return nil, fmt.Errorf("line %d: text without a speaker", lineNum)
+		}
+		last := &inputs[len(inputs)-1]
+		last.Text = strings.TrimSpace(last.Text + " " + line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return inputs, nil
+}
+
+// isSpeakerLabel reports whether s looks like a screenplay speaker name, i.e. contains letters, all of which are
+// upper case.
+func isSpeakerLabel(s string) bool {
+	hasLetter := false
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return false
+		}
+		hasLetter = hasLetter || unicode.IsLetter(r)
+	}
+	return hasLetter
+}
+
+// ScreenplayToDialogue converts a script written in a simple screenplay format into dialogue inputs (see
+// ParseScreenplay), resolving the voice of each speaker through GetVoices.
+//
+// It takes a string argument that contains the script and a map of speaker names to either the name or the ID of
+// the voice they speak with. Voice names are matched case-insensitively.
+//
+// It returns the dialogue inputs, or an error if a voice can't be resolved or the script can't be parsed.
+func (c *Client) ScreenplayToDialogue(script string, speakerVoices map[string]string) ([]DialogueInput, error) {
+	voices, err := c.GetVoices()
+	if err != nil {
+		return nil, err
+	}
+
+	voiceIDs := make(map[string]string, len(speakerVoices))
+	for speaker, voice := range speakerVoices {
+		voiceID, ok := resolveVoiceID(voices, voice)
+		if !ok {
+			return nil, fmt.Errorf("voice %q of speaker %q not found", voice, speaker)
+		}
+		voiceIDs[speaker] = voiceID
+	}
+
+	return ParseScreenplay(script, voiceIDs)
+}
+
+// resolveVoiceID returns the ID of the voice whose ID or name matches nameOrID.
+func resolveVoiceID(voices []Voice, nameOrID string) (string, bool) {
+	for _, v := range voices {
+		if v.VoiceId == nameOrID {
+			return v.VoiceId, true
+		}
+	}
+	for _, v := range voices {
+		if strings.EqualFold(v.Name, nameOrID) {
+			return v.VoiceId, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,143 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestTextToDialogue(t *testing.T) {
+	dialogueReq := elevenlabs.TextToDialogueRequest{
+		Inputs: []elevenlabs.DialogueInput{
+			{Text: "Welcome to the show.", VoiceID: "v1"},
+			{Text: "Thanks for having me!", VoiceID: "v2"},
+		},
+	}
+	respBody := testRespBodies["TestTextToSpeech"]
+	server := testServer(t, testServerConfig{
+		expectedMethod:      http.MethodPost,
+		expectedContentType: contentTypeJSON,
+		expectedAccept:      "*/*",
+		expectedQueryStr:    "output_format=mp3_44100_192",
+		statusCode:          http.StatusOK,
+		responseBody:        respBody,
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	queries := elevenlabs.WithRequestQueries(elevenlabs.OutputFormat("mp3_44100_192"))
+	audio, err := client.TextToDialogue(dialogueReq, queries)
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if !bytes.Equal(audio, respBody) {
+		t.Errorf("Expected response %q, got %q", respBody, audio)
+	}
+
+	w := bytes.Buffer{}
+	if err := client.TextToDialogueStream(&w, dialogueReq, queries); err != nil {
+		t.Fatalf("Expected no errors streaming, got error: %q", err)
+	}
+	if !bytes.Equal(w.Bytes(), respBody) {
+		t.Errorf("Expected streamed response %q, got %q", respBody, w.Bytes())
+	}
+}
+
+func TestTextToDialogueWithTimestamps(t *testing.T) {
+	server := testServer(t, testServerConfig{
+		expectedMethod:      http.MethodPost,
+		expectedContentType: contentTypeJSON,
+		expectedAccept:      "*/*",
+		statusCode:          http.StatusOK,
+		responseBody:        testRespBodies["TestTextToDialogueWithTimestamps"],
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	resp, err := client.TextToDialogueWithTimestamps(elevenlabs.TextToDialogueRequest{
+		Inputs: []elevenlabs.DialogueInput{{Text: "Hi", VoiceID: "v1"}, {Text: "Yo", VoiceID: "v2"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	audio, err := resp.Audio()
+	if err != nil || string(audio) != "audio" {
+		t.Errorf("Expected decoded audio %q, got %q (%v)", "audio", audio, err)
+	}
+	if len(resp.Alignment.Characters) != 4 || len(resp.VoiceSegments) != 2 || resp.VoiceSegments[1].DialogueInputIndex != 1 {
+		t.Errorf("Unexpected TextToDialogueWithTimestampsResponse: %+v", resp)
+	}
+}
+
+func TestParseScreenplay(t *testing.T) {
+	voiceIDs := map[string]string{"Alice": "v1", "BOB": "v2"}
+	testCases := []struct {
+		name      string
+		script    string
+		expInputs []elevenlabs.DialogueInput
+		expError  bool
+	}{
+		{
+			name:   "Speakers, blank lines and continuations",
+			script: "ALICE: Hello there.\n\nBOB: Hi Alice!\nNote: this line continues Bob's.\nalice: Time: 5pm.",
+			expInputs: []elevenlabs.DialogueInput{
+				{Text: "Hello there.", VoiceID: "v1"},
+				{Text: "Hi Alice! Note: this line continues Bob's.", VoiceID: "v2"},
+				{Text: "Time: 5pm.", VoiceID: "v1"},
+			},
+		},
+		{
+			name:     "Unknown speaker",
+			script:   "ALICE: Hello.\nCAROL: Who are you?",
+			expError: true,
+		},
+		{
+			name:     "Text without speaker",
+			script:   "Hello.\nALICE: Hi.",
+			expError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inputs, err := elevenlabs.ParseScreenplay(tc.script, voiceIDs)
+			if tc.expError {
+				if err == nil {
+					t.Errorf("Expected an error, got inputs %+v", inputs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no errors, got error: %q", err)
+			}
+			if !reflect.DeepEqual(inputs, tc.expInputs) {
+				t.Errorf("Expected inputs %+v, got %+v", tc.expInputs, inputs)
+			}
+		})
+	}
+}
+
+func TestScreenplayToDialogue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"voices": [{"voice_id": "id-rachel", "name": "Rachel"}, {"voice_id": "id-adam", "name": "Adam"}]}`))
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	inputs, err := client.ScreenplayToDialogue("HOST: Welcome!\nGUEST: Glad to be here.", map[string]string{"HOST": "rachel", "GUEST": "id-adam"})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	exp := []elevenlabs.DialogueInput{{Text: "Welcome!", VoiceID: "id-rachel"}, {Text: "Glad to be here.", VoiceID: "id-adam"}}
+	if !reflect.DeepEqual(inputs, exp) {
+		t.Errorf("Expected inputs %+v, got %+v", exp, inputs)
+	}
+
+	if _, err := client.ScreenplayToDialogue("HOST: Hi", map[string]string{"HOST": "Nobody"}); err == nil {
+		t.Errorf("Expected an error for an unknown voice")
+	}
+}
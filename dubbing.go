@@ -0,0 +1,101 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	defaultDubbingPollInterval    = 5 * time.Second
+	defaultDubbingMaxPollInterval = 1 * time.Minute
+)
+
+// DubbingJob represents a dubbing project and provides access to its lifecycle: polling its status,
+// retrieving the dubbed media and transcripts, editing segments and deleting it.
+//
+// A DubbingJob is returned by CreateDubbing, or can be obtained for an existing project with DubbingJobByID.
+type DubbingJob struct {
+	ID                  string
+	ExpectedDurationSec float64 // As estimated by the server when the job was created, zero otherwise.
+
+	// PollInterval is the initial delay between status checks performed by Wait. The delay doubles after every
+	// check until it reaches MaxPollInterval.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	client *Client
+}
+
+// DubbingJobByID returns a DubbingJob for an existing dubbing project. No request is made.
+func (c *Client) DubbingJobByID(dubbingID string) *DubbingJob {
+	return &DubbingJob{
+		ID:              dubbingID,
+		PollInterval:    defaultDubbingPollInterval,
+		MaxPollInterval: defaultDubbingMaxPollInterval,
+		client:          c,
+	}
+}
+
+// Status retrieves the current metadata of the dubbing project.
+func (j *DubbingJob) Status(opts ...RequestOption) (DubbingMetadata, error) {
+	return j.client.GetDubbing(j.ID, opts...)
+}
+
+// Wait polls the status of the dubbing project with exponential backoff until dubbing completes, the project
+// fails or ctx is done.
+//
+// It returns the final DubbingMetadata, or an error wrapping ErrDubbingFailed if the project failed.
+func (j *DubbingJob) Wait(ctx context.Context) (DubbingMetadata, error) {
+	interval := j.PollInterval
+	if interval <= 0 {
+		interval = defaultDubbingPollInterval
+	}
+
+	var meta DubbingMetadata
+	err := pollWithBackoff(ctx, interval, j.MaxPollInterval, func() (bool, error) {
+		var err error
+		meta, err = j.Status(WithRequestContext(ctx))
+		if err != nil {
+			return false, err
+		}
+		switch meta.Status {
+		case DubbingStatusDubbed:
+			return true, nil
+		case DubbingStatusFailed:
+			return true, fmt.Errorf("%w: %s", ErrDubbingFailed, meta.Error)
+		}
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, ErrDubbingFailed) {
+		return DubbingMetadata{}, err
+	}
+	return meta, err
+}
+
+// Audio streams the dubbed audio or video for the given target language to w.
+func (j *DubbingJob) Audio(w io.Writer, languageCode string, opts ...RequestOption) error {
+	return j.client.GetDubbedAudio(w, j.ID, languageCode, opts...)
+}
+
+// Transcript retrieves the subtitles for the given language. See TranscriptFormat to select the format.
+func (j *DubbingJob) Transcript(languageCode string, opts ...RequestOption) ([]byte, error) {
+	return j.client.GetDubbingTranscript(j.ID, languageCode, opts...)
+}
+
+// Resource retrieves the editable transcript of the project. It requires the job to be created with DubbingStudio set.
+func (j *DubbingJob) Resource(opts ...RequestOption) (DubbingResource, error) {
+	return j.client.GetDubbingResource(j.ID, opts...)
+}
+
+// EditSegment updates the timing or text of a segment for the given language.
+func (j *DubbingJob) EditSegment(segmentID, languageCode string, update DubbingSegmentUpdate, opts ...RequestOption) error {
+	return j.client.EditDubbingSegment(j.ID, segmentID, languageCode, update, opts...)
+}
+
+// Delete deletes the dubbing project.
+func (j *DubbingJob) Delete(opts ...RequestOption) error {
+	return j.client.DeleteDubbing(j.ID, opts...)
+}
@@ -0,0 +1,158 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func testDubbingServer(t *testing.T, statuses ...string) *httptest.Server {
+	t.Helper()
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("xi-api-key"); got != mockAPIKey {
+			t.Errorf("Server: expected API Key %q, got %q", mockAPIKey, got)
+		}
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "POST /dubbing":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse multipart form: %v", err)
+			}
+			if r.FormValue("target_lang") != "es" || r.FormValue("num_speakers") != "2" {
+				t.Errorf("Server: unexpected form values %v", r.MultipartForm.Value)
+			}
+			w.Write([]byte(`{"dubbing_id": "TestDubbingID", "expected_duration_sec": 12.5}`))
+		case "GET /dubbing/TestDubbingID":
+			status := statuses[polls]
+			if polls < len(statuses)-1 {
+				polls++
+			}
+			meta := elevenlabs.DubbingMetadata{DubbingID: "TestDubbingID", Status: status, TargetLanguages: []string{"es"}}
+			if status == elevenlabs.DubbingStatusFailed {
+				meta.Error = "no speech detected"
+			}
+			json.NewEncoder(w).Encode(meta)
+		case "GET /dubbing/TestDubbingID/audio/es":
+			w.Write([]byte("dubbed audio"))
+		case "GET /dubbing/TestDubbingID/transcript/es":
+			if r.URL.RawQuery != "format_type=webvtt" {
+				t.Errorf("Server: expected query string %q, got %q", "format_type=webvtt", r.URL.RawQuery)
+			}
+			w.Write([]byte("WEBVTT"))
+		case "GET /dubbing/resource/TestDubbingID":
+			w.Write(testRespBodies["TestGetDubbingResource"])
+		case "PATCH /dubbing/resource/TestDubbingID/segment/seg1/es":
+			var update map[string]any
+			json.NewDecoder(r.Body).Decode(&update)
+			if len(update) != 1 || update["text"] != "Hola a todos" {
+				t.Errorf("Server: unexpected segment update %v", update)
+			}
+			w.Write([]byte(`{"version": 2}`))
+		case "DELETE /dubbing/TestDubbingID":
+			w.Write([]byte(`{"status": "ok"}`))
+		default:
+			t.Errorf("Server: unexpected request %q", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestDubbingJobLifecycle(t *testing.T) {
+	server := testDubbingServer(t, elevenlabs.DubbingStatusDubbing, elevenlabs.DubbingStatusDubbing, elevenlabs.DubbingStatusDubbed)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	job, err := client.CreateDubbing(elevenlabs.DubbingRequest{
+		File:        strings.NewReader("fake video"),
+		FileName:    "video.mp4",
+		TargetLang:  "es",
+		NumSpeakers: 2,
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors creating dub, got error: %q", err)
+	}
+	if job.ID != "TestDubbingID" || job.ExpectedDurationSec != 12.5 {
+		t.Errorf("Unexpected DubbingJob: %+v", job)
+	}
+
+	job.PollInterval = time.Millisecond
+	meta, err := job.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no errors waiting, got error: %q", err)
+	}
+	if meta.Status != elevenlabs.DubbingStatusDubbed {
+		t.Errorf("Expected status %q, got %q", elevenlabs.DubbingStatusDubbed, meta.Status)
+	}
+
+	w := bytes.Buffer{}
+	if err := job.Audio(&w, "es"); err != nil {
+		t.Fatalf("Expected no errors getting audio, got error: %q", err)
+	}
+	if w.String() != "dubbed audio" {
+		t.Errorf("Unexpected dubbed audio %q", w.String())
+	}
+
+	transcript, err := job.Transcript("es", elevenlabs.WithRequestQueries(elevenlabs.TranscriptFormat("webvtt")))
+	if err != nil {
+		t.Fatalf("Expected no errors getting transcript, got error: %q", err)
+	}
+	if string(transcript) != "WEBVTT" {
+		t.Errorf("Unexpected transcript %q", transcript)
+	}
+
+	resource, err := job.Resource()
+	if err != nil {
+		t.Fatalf("Expected no errors getting resource, got error: %q", err)
+	}
+	if seg := resource.SpeakerSegments["seg1"]; seg.Text != "Hello everyone" || seg.Dubs["es"].Text != "Hola a todos." {
+		t.Errorf("Unexpected DubbingResource: %+v", resource)
+	}
+
+	text := "Hola a todos"
+	if err := job.EditSegment("seg1", "es", elevenlabs.DubbingSegmentUpdate{Text: &text}); err != nil {
+		t.Fatalf("Expected no errors editing segment, got error: %q", err)
+	}
+
+	if err := job.Delete(); err != nil {
+		t.Fatalf("Expected no errors deleting, got error: %q", err)
+	}
+}
+
+func TestDubbingJobWaitFailed(t *testing.T) {
+	server := testDubbingServer(t, elevenlabs.DubbingStatusDubbing, elevenlabs.DubbingStatusFailed)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	job := client.DubbingJobByID("TestDubbingID")
+	job.PollInterval = time.Millisecond
+	meta, err := job.Wait(context.Background())
+	if !errors.Is(err, elevenlabs.ErrDubbingFailed) {
+		t.Fatalf("Expected error wrapping %q, got %v", elevenlabs.ErrDubbingFailed, err)
+	}
+	if !strings.Contains(err.Error(), "no speech detected") || meta.Status != elevenlabs.DubbingStatusFailed {
+		t.Errorf("Unexpected failure %q with metadata %+v", err, meta)
+	}
+}
+
+func TestDubbingJobWaitContextCancel(t *testing.T) {
+	server := testDubbingServer(t, elevenlabs.DubbingStatusDubbing)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	job := client.DubbingJobByID("TestDubbingID")
+	job.PollInterval = time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := job.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline exceeded error, got %v", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -32,6 +34,7 @@ type testServerConfig struct {
 	expectedQueryStr    string
 	statusCode          int
 	responseBody        []byte
+	responseHeader      http.Header
 	responseDelay       time.Duration
 }
 
@@ -72,6 +75,9 @@ func testServer(t *testing.T, config testServerConfig) *httptest.Server {
 			time.Sleep(config.responseDelay)
 		}
 
+		for k, v := range config.responseHeader {
+			w.Header()[k] = v
+		}
 		w.WriteHeader(config.statusCode)
 		w.Write(config.responseBody)
 	}))
@@ -1013,3 +1019,413 @@ func TestSpeechToText(t *testing.T) {
 		})
 	}
 }
+
+func TestForcedAlignment(t *testing.T) {
+	respBody := testRespBodies["TestForcedAlignment"]
+	server := testServer(t, testServerConfig{
+		expectedMethod:      http.MethodPost,
+		expectedContentType: contentMultipart,
+		expectedAccept:      "*/*",
+		statusCode:          http.StatusOK,
+		responseBody:        respBody,
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	resp, err := client.ForcedAlignment(elevenlabs.ForcedAlignmentRequest{
+		File:     strings.NewReader("fake audio data"),
+		FileName: "test.mp3",
+		Text:     "Hi",
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	var expResp elevenlabs.ForcedAlignmentResponse
+	if err := json.Unmarshal(respBody, &expResp); err != nil {
+		t.Fatalf("Failed to unmarshal test respBody: %s", err)
+	}
+	if !reflect.DeepEqual(resp, expResp) {
+		t.Errorf("Unexpected ForcedAlignmentResponse: %+v", resp)
+	}
+	words := resp.SpeechToTextWords()
+	if len(words) != 1 || words[0].Text != "Hi" || words[0].End != 0.2 || words[0].Type != "word" {
+		t.Errorf("Unexpected words converted from alignment: %+v", words)
+	}
+}
+
+func TestGenerateSoundEffect(t *testing.T) {
+	duration := 2.5
+	testCases := []struct {
+		name           string
+		queries        []elevenlabs.QueryFunc
+		expQueryString string
+	}{
+		{
+			name: "No queries",
+		},
+		{
+			name:           "With output format",
+			queries:        []elevenlabs.QueryFunc{elevenlabs.OutputFormat("pcm_44100")},
+			expQueryString: "output_format=pcm_44100",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			respBody := []byte("audio bytes")
+			server := testServer(t, testServerConfig{
+				expectedMethod:      http.MethodPost,
+				expectedContentType: contentTypeJSON,
+				expectedAccept:      "*/*",
+				expectedQueryStr:    tc.expQueryString,
+				statusCode:          http.StatusOK,
+				responseBody:        respBody,
+				responseHeader: http.Header{
+					"Content-Type":   []string{"audio/mpeg"},
+					"Request-Id":     []string{"TestRequestID"},
+					"Character-Cost": []string{"120"},
+				},
+			})
+			defer server.Close()
+
+			client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+			sfxReq := elevenlabs.SoundEffectRequest{Text: "Door creaking open", DurationSeconds: &duration, Loop: true}
+			audio, meta, err := client.GenerateSoundEffect(sfxReq, elevenlabs.WithRequestQueries(tc.queries...))
+			if err != nil {
+				t.Fatalf("Expected no errors, got error: %q", err)
+			}
+			if !bytes.Equal(audio, respBody) {
+				t.Errorf("Expected returned audio to be %q, got %q", respBody, audio)
+			}
+			expMeta := elevenlabs.ResponseMetadata{RequestID: "TestRequestID", ContentType: "audio/mpeg", CharacterCost: 120}
+			if meta != expMeta {
+				t.Errorf("Expected metadata %+v, got %+v", expMeta, meta)
+			}
+
+			w := bytes.Buffer{}
+			if _, err := client.GenerateSoundEffectStream(&w, sfxReq, elevenlabs.WithRequestQueries(tc.queries...)); err != nil {
+				t.Fatalf("Expected no errors streaming, got error: %q", err)
+			}
+			if !bytes.Equal(w.Bytes(), respBody) {
+				t.Errorf("Expected streamed audio to be %q, got %q", respBody, w.Bytes())
+			}
+		})
+	}
+}
+
+func TestSpeechToSpeech(t *testing.T) {
+	testCases := []struct {
+		name           string
+		stream         bool
+		queries        []elevenlabs.QueryFunc
+		expQueryString string
+	}{
+		{
+			name: "No queries",
+		},
+		{
+			name:           "With latency optimizations and output format queries",
+			queries:        []elevenlabs.QueryFunc{elevenlabs.LatencyOptimizations(3), elevenlabs.OutputFormat("mp3_44100_32")},
+			expQueryString: "optimize_streaming_latency=3&output_format=mp3_44100_32",
+		},
+		{
+			name:           "Stream with output format query",
+			stream:         true,
+			queries:        []elevenlabs.QueryFunc{elevenlabs.OutputFormat("pcm_16000")},
+			expQueryString: "output_format=pcm_16000",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			respBody := testRespBodies["TestTextToSpeech"]
+			server := testServer(t, testServerConfig{
+				expectedMethod:      http.MethodPost,
+				expectedContentType: contentMultipart,
+				expectedAccept:      "*/*",
+				expectedQueryStr:    tc.expQueryString,
+				statusCode:          http.StatusOK,
+				responseBody:        respBody,
+			})
+			defer server.Close()
+
+			client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+			stsReq := elevenlabs.SpeechToSpeechRequest{
+				Audio:                 strings.NewReader("fake audio data"),
+				FileName:              "performance.mp3",
+				ModelID:               "eleven_multilingual_sts_v2",
+				VoiceSettings:         &elevenlabs.VoiceSettings{Stability: 0.5, SimilarityBoost: 0.75},
+				RemoveBackgroundNoise: &[]bool{true}[0],
+			}
+			var audio []byte
+			var err error
+			if tc.stream {
+				w := bytes.Buffer{}
+				err = client.SpeechToSpeechStream(&w, "voiceID", stsReq, elevenlabs.WithRequestQueries(tc.queries...))
+				audio = w.Bytes()
+			} else {
+				audio, err = client.SpeechToSpeech("voiceID", stsReq, elevenlabs.WithRequestQueries(tc.queries...))
+			}
+			if err != nil {
+				t.Fatalf("Expected no errors, got error: %q", err)
+			}
+			if !bytes.Equal(audio, respBody) {
+				t.Errorf("Expected response %q, got %q", respBody, audio)
+			}
+		})
+	}
+}
+
+func TestAudioIsolation(t *testing.T) {
+	for _, stream := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Stream %t", stream), func(t *testing.T) {
+			respBody := testRespBodies["TestTextToSpeech"]
+			server := testServer(t, testServerConfig{
+				expectedMethod:      http.MethodPost,
+				expectedContentType: contentMultipart,
+				expectedAccept:      "*/*",
+				statusCode:          http.StatusOK,
+				responseBody:        respBody,
+			})
+			defer server.Close()
+
+			client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+			isoReq := elevenlabs.AudioIsolationRequest{Audio: strings.NewReader("noisy audio"), FileName: "noisy.mp3"}
+			var audio []byte
+			var err error
+			if stream {
+				w := bytes.Buffer{}
+				err = client.AudioIsolationStream(&w, isoReq)
+				audio = w.Bytes()
+			} else {
+				audio, err = client.AudioIsolation(isoReq)
+			}
+			if err != nil {
+				t.Fatalf("Expected no errors, got error: %q", err)
+			}
+			if !bytes.Equal(audio, respBody) {
+				t.Errorf("Expected response %q, got %q", respBody, audio)
+			}
+		})
+	}
+}
+
+func TestAddVoiceWithIsolation(t *testing.T) {
+	var isolated, added int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/audio-isolation/stream":
+			isolated++
+			w.Write([]byte("isolated audio"))
+		case "/voices/add":
+			added++
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse multipart form: %v", err)
+			}
+			for _, fh := range r.MultipartForm.File["files"] {
+				f, _ := fh.Open()
+				b, _ := io.ReadAll(f)
+				f.Close()
+				if string(b) != "isolated audio" || fh.Filename != "fake.mp3" {
+					t.Errorf("Server: expected isolated sample named fake.mp3, got %q with %q", fh.Filename, b)
+				}
+			}
+			w.Write([]byte(`{"voice_id":"TestVoiceId"}`))
+		default:
+			t.Errorf("Server: unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	voiceID, err := client.AddVoiceWithIsolation(elevenlabs.AddEditVoiceRequest{
+		Name:      "TestVoice",
+		FilePaths: []string{"testdata/fake.mp3", "testdata/fake.mp3"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if voiceID != "TestVoiceId" {
+		t.Errorf("Expected voice ID %q, got %q", "TestVoiceId", voiceID)
+	}
+	if isolated != 2 || added != 1 {
+		t.Errorf("Expected 2 isolation requests and 1 add request, got %d and %d", isolated, added)
+	}
+}
+
+func TestDesignVoice(t *testing.T) {
+	server := testServer(t, testServerConfig{
+		expectedMethod:      http.MethodPost,
+		expectedContentType: contentTypeJSON,
+		expectedAccept:      "*/*",
+		expectedQueryStr:    "output_format=mp3_22050_32",
+		statusCode:          http.StatusOK,
+		responseBody:        testRespBodies["TestDesignVoice"],
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	resp, err := client.DesignVoice(elevenlabs.VoiceDesignRequest{
+		VoiceDescription: "A calm, elderly British narrator with a warm tone",
+		AutoGenerateText: true,
+	}, elevenlabs.WithRequestQueries(elevenlabs.OutputFormat("mp3_22050_32")))
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if len(resp.Previews) != 2 || resp.Previews[0].GeneratedVoiceID != "gen1" || resp.Text == "" {
+		t.Fatalf("Unexpected VoiceDesignResponse: %+v", resp)
+	}
+	if audio, err := resp.Previews[0].Audio(); err != nil || string(audio) != "preview" {
+		t.Errorf("Unexpected preview audio %q, %v", audio, err)
+	}
+}
+
+func TestCreateVoiceFromPreview(t *testing.T) {
+	respBody := testRespBodies["TestGetVoice"]
+	server := testServer(t, testServerConfig{
+		expectedMethod:      http.MethodPost,
+		expectedContentType: contentTypeJSON,
+		expectedAccept:      "*/*",
+		statusCode:          http.StatusOK,
+		responseBody:        respBody,
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	voice, err := client.CreateVoiceFromPreview(elevenlabs.CreateVoiceFromPreviewRequest{
+		VoiceName:                 "Narrator",
+		VoiceDescription:          "A calm, elderly British narrator with a warm tone",
+		GeneratedVoiceID:          "gen1",
+		Labels:                    map[string]string{"use_case": "narration"},
+		PlayedNotSelectedVoiceIDs: []string{"gen2"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	var expVoice elevenlabs.Voice
+	if err := json.Unmarshal(respBody, &expVoice); err != nil {
+		t.Fatalf("Failed to unmarshal test respBody: %s", err)
+	}
+	if !reflect.DeepEqual(expVoice, voice) {
+		t.Errorf("Unexpected Voice in response: %+v", voice)
+	}
+}
+
+func TestGetSharedVoices(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/shared-voices" {
+			t.Errorf("Server: unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("page") == "" {
+			w.Write(testRespBodies["TestGetSharedVoices"])
+			return
+		}
+		w.Write([]byte(`{"voices": [], "has_more": false}`))
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	resp, nextPage, err := client.GetSharedVoices(elevenlabs.SharedVoicesFilter{
+		Gender:   "male",
+		Accent:   "british",
+		UseCases: []string{"narrative_story", "audiobook"},
+		Featured: true,
+		Sort:     elevenlabs.SharedVoicesSortMostUsers,
+	}, elevenlabs.PageSize(1))
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if len(resp.Voices) != 1 || nextPage == nil {
+		t.Fatalf("Expected 1 voice and a next page, got %+v", resp)
+	}
+	voice := resp.Voices[0]
+	if voice.VoiceID != "sv1" || voice.PublicOwnerId != "owner1" || voice.Name != "Lighthouse Keeper" || voice.ClonedByCount != 412 || !voice.Featured {
+		t.Errorf("Unexpected SharedVoice: %+v", voice)
+	}
+
+	if _, nextPage, err = nextPage(); err != nil || nextPage != nil {
+		t.Errorf("Expected a last page without errors, got %v", err)
+	}
+	expQueries := []string{
+		"accent=british&featured=true&gender=male&page_size=1&sort=cloned_by_count&use_cases=narrative_story&use_cases=audiobook",
+		"accent=british&featured=true&gender=male&page=1&page_size=1&sort=cloned_by_count&use_cases=narrative_story&use_cases=audiobook",
+	}
+	if !reflect.DeepEqual(queries, expQueries) {
+		t.Errorf("Expected queries %q, got %q", expQueries, queries)
+	}
+}
+
+func TestAddSharedVoice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if r.Method != http.MethodPost || r.URL.Path != "/voices/add/owner1/sv1" || body["new_name"] != "Keeper" {
+			t.Errorf("Server: unexpected request %s %s %v", r.Method, r.URL.Path, body)
+		}
+		w.Write([]byte(`{"voice_id": "v42"}`))
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	voiceID, err := client.AddSharedVoice("owner1", "sv1", "Keeper")
+	if err != nil || voiceID != "v42" {
+		t.Errorf("Unexpected voice ID %q, %v", voiceID, err)
+	}
+}
+
+func TestAddVoiceFromReaders(t *testing.T) {
+	fakeMP3, err := os.ReadFile("testdata/fake.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Server: failed to parse form: %v", err)
+			return
+		}
+		if r.FormValue("name") != "Generated" || r.FormValue("remove_background_noise") != "true" {
+			t.Errorf("Server: unexpected fields %v", r.MultipartForm.Value)
+		}
+		var files []string
+		for _, header := range r.MultipartForm.File["files"] {
+			f, err := header.Open()
+			if err != nil {
+				t.Errorf("Server: failed to open %s: %v", header.Filename, err)
+				return
+			}
+			content, _ := io.ReadAll(f)
+			f.Close()
+			files = append(files, fmt.Sprintf("%s %s %s", header.Filename, header.Header.Get("Content-Type"), content))
+		}
+		expFiles := []string{
+			"fake.mp3 application/octet-stream " + string(fakeMP3),
+			`take "1".wav audio/wav RIFF`,
+			"take2.raw application/octet-stream raw",
+		}
+		if !reflect.DeepEqual(files, expFiles) {
+			t.Errorf("Server: expected files %q, got %q", expFiles, files)
+		}
+		w.Write([]byte(`{"voice_id": "TestVoiceId"}`))
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	id, err := client.AddVoice(elevenlabs.AddEditVoiceRequest{
+		Name:      "Generated",
+		FilePaths: []string{"testdata/fake.mp3"},
+		Files: []elevenlabs.SampleFile{
+			{FileName: `take "1".wav`, MimeType: "audio/wav", Content: strings.NewReader("RIFF")},
+			{FileName: "take2.raw", Content: bytes.NewReader([]byte("raw"))},
+		},
+		RemoveBackgroundNoise: true,
+	})
+	if err != nil || id != "TestVoiceId" {
+		t.Errorf("Unexpected voice ID %q, %v", id, err)
+	}
+
+	_, err = client.AddVoice(elevenlabs.AddEditVoiceRequest{Name: "Empty", Files: []elevenlabs.SampleFile{{FileName: "empty.mp3"}}})
+	if err == nil {
+		t.Error("Expected an error for a sample without content, got nil")
+	}
+}
@@ -1,6 +1,7 @@
 package elevenlabs
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -47,3 +48,57 @@ func (e *ValidationError) Error() string {
 	}
 	return "validation error"
 }
+
+// WebSocketError represents an error message sent by the server over a WebSocket session, such as
+// an authentication failure or an exceeded quota reported by the realtime speech-to-text endpoint.
+type WebSocketError struct {
+	Type    string
+	Message string
+}
+
+func (e *WebSocketError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("websocket error - %s", e.Type)
+	}
+	return fmt.Sprintf("websocket error - %s: %s", e.Type, e.Message)
+}
+
+// ErrDubbingFailed is returned, wrapped with the reason reported by the server, by DubbingJob.Wait when
+// the dubbing project ends in the failed state.
+var ErrDubbingFailed = errors.New("dubbing failed")
+
+// ErrRAGIndexFailed is returned, wrapped with the status reported by the server, by WaitForRAGIndex when
+// indexing fails or the RAG storage limit of the account is exceeded.
+var ErrRAGIndexFailed = errors.New("RAG indexing failed")
+
+// ErrConversationNotStarted is returned by the Conversation methods sending messages when the conversation
+// hasn't been started, or failed to start.
+var ErrConversationNotStarted = errors.New("conversation not started")
+
+// ErrConversationClosed is returned by Conversation.Start when the conversation was already started, even if
+// that failed, or was closed. A new Conversation must be created to start again.
+var ErrConversationClosed = errors.New("conversation already started or closed")
+
+// ErrPlaybackCleared is returned by TelephonyCall.Speak when the audio being played to the caller is cleared,
+// typically because the caller started speaking.
+var ErrPlaybackCleared = errors.New("playback cleared")
+
+// ErrPVCStep is returned, wrapped with the action attempted, by the PVCWorkflow methods called at a step of the
+// workflow they don't belong to.
+var ErrPVCStep = errors.New("action not allowed at this step of the PVC workflow")
+
+// ErrSpeakerSeparationFailed is returned by PVCWorkflow.SeparateSpeakers when the speakers of a sample couldn't
+// be separated.
+var ErrSpeakerSeparationFailed = errors.New("speaker separation failed")
+
+// ErrFineTuningFailed is returned, wrapped with the verification failures reported by the server, by
+// PVCWorkflow.WaitForTraining when the training of the voice fails.
+var ErrFineTuningFailed = errors.New("fine-tuning failed")
+
+// ErrInvalidVoiceSettings is returned, wrapped with the problems found, by VoiceSettings.Validate when settings
+// would be rejected by the API.
+var ErrInvalidVoiceSettings = errors.New("invalid voice settings")
+
+// ErrInvalidVoiceCatalog is returned, wrapped with the reason, by PlanVoiceCatalog when the desired catalog can't
+// be applied.
+var ErrInvalidVoiceCatalog = errors.New("invalid voice catalog")
@@ -1,3 +1,5 @@
 module github.com/hoshii-ai/elevenlabs-go
 
 go 1.18
+
+require github.com/gorilla/websocket v1.5.3
@@ -0,0 +1,48 @@
+package elevenlabs
+
+import "context"
+
+// pageIterator walks the elements of a cursor-paginated list, fetching pages lazily as they are consumed. It
+// backs the exported iterators of the package, which only add an accessor for the current element.
+type pageIterator[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, cursor string) (items []T, nextCursor string, err error)
+
+	page    []T
+	i       int
+	cursor  string
+	started bool
+	cur     T
+	err     error
+}
+
+// Next advances the iterator to the next element, fetching the next page if needed. It returns false when there
+// are no more elements or an error occurred, in which case Err reports it.
+func (it *pageIterator[T]) Next() bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+		if it.i < len(it.page) {
+			it.cur = it.page[it.i]
+			it.i++
+			return true
+		}
+		if it.started && it.cursor == "" {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page, it.cursor, it.err = it.fetch(it.ctx, it.cursor)
+		it.i = 0
+		it.started = true
+	}
+}
+
+// Err returns the error that stopped the iteration, if any, including the cancellation of its context.
+func (it *pageIterator[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,239 @@
+package elevenlabs
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// JSONSchemaFor returns the JSONSchema describing the JSON encoding of v, which is typically the zero value of a
+// struct holding the parameters of a tool.
+//
+// Properties are named after their `json` struct tag, and the fields of embedded structs are promoted like
+// encoding/json does. Fields are required unless they are pointers, tagged with omitempty or promoted from an
+// embedded pointer. Descriptions and allowed values are read from the `description` and comma-separated `enum`
+// struct tags, the latter applying to the elements of slices:
+//
+//	type GetOrderParams struct {
+//		OrderID string `json:"order_id" description:"ID of the order, as given by the customer"`
+//		Detail  string `json:"detail,omitempty" enum:"summary,full"`
+//	}
+//
+// It returns an error if v contains types that can't be described, such as channels or functions, or recursive
+// types.
+func JSONSchemaFor(v interface{}) (JSONSchema, error) {
+	return jsonSchemaForType(reflect.TypeOf(v), map[reflect.Type]bool{})
+}
+
+// jsonSchemaForType describes t. visiting holds the struct types being described, to detect recursive types.
+func jsonSchemaForType(t reflect.Type, visiting map[reflect.Type]bool) (JSONSchema, error) {
+	if t == nil {
+		return JSONSchema{}, fmt.Errorf("can't describe nil value")
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return JSONSchema{Type: "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return JSONSchema{Type: "string"}, nil
+	case reflect.Bool:
+		return JSONSchema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return JSONSchema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return JSONSchema{Type: "number"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			// Like encoding/json, byte slices are encoded as base64 strings.
+			return JSONSchema{Type: "string", ContentEncoding: "base64"}, nil
+		}
+		items, err := jsonSchemaForType(t.Elem(), visiting)
+		if err != nil {
+			return JSONSchema{}, err
+		}
+		return JSONSchema{Type: "array", Items: &items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return JSONSchema{}, fmt.Errorf("can't describe map with %s keys", t.Key())
+		}
+		return JSONSchema{Type: "object"}, nil
+	case reflect.Interface:
+		return JSONSchema{Type: "object"}, nil
+	case reflect.Struct:
+		if visiting[t] {
+			return JSONSchema{}, fmt.Errorf("can't describe recursive type %s", t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		return jsonSchemaForStruct(t, visiting)
+	}
+	return JSONSchema{}, fmt.Errorf("can't describe values of type %s", t)
+}
+
+// jsonField represents a field of a struct encoded by encoding/json, possibly promoted from an embedded struct.
+type jsonField struct {
+	name     string
+	field    reflect.StructField
+	depth    int  // Number of embedded structs the field is promoted through
+	tagged   bool // Whether the name comes from a `json` tag
+	optional bool
+}
+
+func jsonSchemaForStruct(t reflect.Type, visiting map[reflect.Type]bool) (JSONSchema, error) {
+	fields, err := jsonFields(t, 0, false, visiting)
+	if err != nil {
+		return JSONSchema{}, err
+	}
+
+	schema := JSONSchema{Type: "object", Properties: map[string]JSONSchema{}}
+	for _, f := range dominantJSONFields(fields) {
+		prop, err := jsonSchemaForType(f.field.Type, visiting)
+		if err != nil {
+			return JSONSchema{}, fmt.Errorf("field %s: %w", f.field.Name, err)
+		}
+		prop.Description = f.field.Tag.Get("description")
+		if enum := f.field.Tag.Get("enum"); enum != "" {
+			// The allowed values of a slice are those of its elements.
+			target, kind := &prop, f.field.Type
+			for kind.Kind() == reflect.Ptr {
+				kind = kind.Elem()
+			}
+			if prop.Items != nil {
+				target, kind = prop.Items, kind.Elem()
+				for kind.Kind() == reflect.Ptr {
+					kind = kind.Elem()
+				}
+			}
+			if target.Enum, err = parseJSONEnum(enum, kind); err != nil {
+				return JSONSchema{}, fmt.Errorf("field %s: %w", f.field.Name, err)
+			}
+		}
+		schema.Properties[f.name] = prop
+		if !f.optional {
+			schema.Required = append(schema.Required, f.name)
+		}
+	}
+	return schema, nil
+}
+
+// jsonFields lists the fields encoded by encoding/json for t, recursing into embedded structs without a name tag.
+func jsonFields(t reflect.Type, depth int, optional bool, visiting map[reflect.Type]bool) ([]jsonField, error) {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		ft := field.Type
+		if field.Anonymous && ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if field.Anonymous && name == "" && ft.Kind() == reflect.Struct && ft != timeType {
+			// Like encoding/json, the fields of embedded structs are promoted, even if the struct type isn't
+			// exported.
+			if visiting[ft] {
+				return nil, fmt.Errorf("can't describe recursive type %s", ft)
+			}
+			visiting[ft] = true
+			promoted, err := jsonFields(ft, depth+1, optional || field.Type.Kind() == reflect.Ptr, visiting)
+			delete(visiting, ft)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, promoted...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		f := jsonField{name: name, field: field, depth: depth, tagged: name != "", optional: optional || field.Type.Kind() == reflect.Ptr}
+		if name == "" {
+			f.name = field.Name
+		}
+		for _, opt := range strings.Split(opts, ",") {
+			f.optional = f.optional || opt == "omitempty"
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// dominantJSONFields resolves fields sharing a name like encoding/json: the least nested one wins, then the one
+// with a name tag. Fields that remain ambiguous are dropped.
+func dominantJSONFields(fields []jsonField) []jsonField {
+	byName := map[string][]jsonField{}
+	var names []string
+	for _, f := range fields {
+		if _, ok := byName[f.name]; !ok {
+			names = append(names, f.name)
+		}
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	var dominant []jsonField
+	for _, name := range names {
+		candidates := byName[name]
+		depth := candidates[0].depth
+		for _, f := range candidates {
+			if f.depth < depth {
+				depth = f.depth
+			}
+		}
+		var shallowest, tagged []jsonField
+		for _, f := range candidates {
+			if f.depth == depth {
+				shallowest = append(shallowest, f)
+				if f.tagged {
+					tagged = append(tagged, f)
+				}
+			}
+		}
+		switch {
+		case len(shallowest) == 1:
+			dominant = append(dominant, shallowest[0])
+		case len(tagged) == 1:
+			dominant = append(dominant, tagged[0])
+		}
+	}
+	return dominant
+}
+
+// parseJSONEnum parses the comma-separated allowed values of an enum tag according to the kind of the values.
+func parseJSONEnum(enum string, t reflect.Type) ([]interface{}, error) {
+	var values []interface{}
+	for _, s := range strings.Split(enum, ",") {
+		var v interface{}
+		var err error
+		switch t.Kind() {
+		case reflect.String:
+			v = s
+		case reflect.Bool:
+			v, err = strconv.ParseBool(s)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v, err = strconv.ParseInt(s, 10, t.Bits())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v, err = strconv.ParseUint(s, 10, t.Bits())
+		case reflect.Float32, reflect.Float64:
+			v, err = strconv.ParseFloat(s, 64)
+		default:
+			return nil, fmt.Errorf("can't restrict values of type %s with an enum", t)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid enum value %q for type %s", s, t)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
@@ -0,0 +1,203 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const maxRAGIndexPollInterval = 30 * time.Second
+
+// WaitForRAGIndex starts indexing a knowledge base document with ComputeRAGIndex if needed, then polls the index
+// with exponential backoff, starting at pollInterval or one second if it isn't positive, until indexing succeeds,
+// fails or ctx is done.
+//
+// It returns the last RAGIndex retrieved, and an error wrapping ErrRAGIndexFailed if indexing failed.
+func (c *Client) WaitForRAGIndex(ctx context.Context, documentID, model string, pollInterval time.Duration) (RAGIndex, error) {
+	var index RAGIndex
+	err := pollWithBackoff(ctx, pollInterval, maxRAGIndexPollInterval, func() (bool, error) {
+		var err error
+		index, err = c.ComputeRAGIndex(documentID, model, WithRequestContext(ctx))
+		if err != nil {
+			return false, err
+		}
+		switch index.Status {
+		case RAGIndexStatusSucceeded:
+			return true, nil
+		case RAGIndexStatusFailed, RAGIndexStatusLimitExceeded:
+			return true, fmt.Errorf("%w: %s", ErrRAGIndexFailed, index.Status)
+		}
+		return false, nil
+	})
+	return index, err
+}
+
+const (
+	KnowledgeBaseSyncUpload  = "upload"
+	KnowledgeBaseSyncReplace = "replace"
+	KnowledgeBaseSyncDelete  = "delete"
+)
+
+// KnowledgeBaseSyncOptions represents the settings of SyncKnowledgeBase.
+type KnowledgeBaseSyncOptions struct {
+	// Prefix namespaces the documents managed by the sync, e.g. "handbook/", so that several directories and
+	// documents added by other means can share the knowledge base.
+	Prefix string
+	// Prune deletes the documents whose file no longer exists.
+	Prune bool
+	// DryRun only computes the actions, without changing the knowledge base.
+	DryRun bool
+}
+
+// KnowledgeBaseSyncAction represents a change made, or to be made in dry-run mode, by SyncKnowledgeBase.
+type KnowledgeBaseSyncAction struct {
+	Action   string                // KnowledgeBaseSyncUpload, KnowledgeBaseSyncReplace or KnowledgeBaseSyncDelete
+	Path     string                // Path of the file in the synced directory
+	Previous KnowledgeBaseDocument // Replaced or deleted document, if any
+	Document KnowledgeBaseDocument // Uploaded document, if any. Its ID is empty in dry-run mode
+}
+
+// SyncKnowledgeBase makes the knowledge base mirror the files of a directory, e.g. os.DirFS("docs"), uploading
+// new files and replacing the documents of files whose content changed.
+//
+// Synced documents are named after the prefix and path of their file, followed by a short SHA-256 hash of its
+// content, e.g. "handbook/hr/leave.md #9f86d081884c7d65", which is how changes are detected without downloading
+// the documents. Replaced documents are deleted once their new version is uploaded, so agents referencing them
+// must be updated with the Locator of the new documents, and deletions fail for documents still in use. Extra
+// documents of a file, left over when such a deletion failed, are deleted by the next sync.
+//
+// It returns the actions taken, ordered by path, or the actions taken so far and an error.
+func (c *Client) SyncKnowledgeBase(ctx context.Context, fsys fs.FS, options KnowledgeBaseSyncOptions) ([]KnowledgeBaseSyncAction, error) {
+	local := map[string][]byte{}
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		local[p] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := c.syncedKnowledgeBaseDocuments(ctx, options.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []KnowledgeBaseSyncAction
+	for p, content := range local {
+		name := syncedDocumentName(options.Prefix, p, content)
+		docs := remote[p]
+		current := -1
+		for i, doc := range docs {
+			if doc.Name == name {
+				current = i
+				break
+			}
+		}
+		switch {
+		case len(docs) == 0:
+			plan = append(plan, KnowledgeBaseSyncAction{Action: KnowledgeBaseSyncUpload, Path: p, Document: KnowledgeBaseDocument{Name: name}})
+		case current < 0:
+			plan = append(plan, KnowledgeBaseSyncAction{Action: KnowledgeBaseSyncReplace, Path: p, Previous: docs[0], Document: KnowledgeBaseDocument{Name: name}})
+			docs = docs[1:]
+		default:
+			docs = append(docs[:current:current], docs[current+1:]...)
+		}
+		// Extra documents are left over by interrupted syncs, e.g. when deleting a replaced version failed.
+		for _, doc := range docs {
+			plan = append(plan, KnowledgeBaseSyncAction{Action: KnowledgeBaseSyncDelete, Path: p, Previous: doc})
+		}
+	}
+	if options.Prune {
+		for p, docs := range remote {
+			if _, exists := local[p]; exists {
+				continue
+			}
+			for _, doc := range docs {
+				plan = append(plan, KnowledgeBaseSyncAction{Action: KnowledgeBaseSyncDelete, Path: p, Previous: doc})
+			}
+		}
+	}
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].Path < plan[j].Path })
+
+	if options.DryRun {
+		return plan, nil
+	}
+
+	for i, action := range plan {
+		if action.Action != KnowledgeBaseSyncDelete {
+			doc, err := c.AddKnowledgeBaseFile(AddKnowledgeBaseFileRequest{
+				Name:     action.Document.Name,
+				File:     bytes.NewReader(local[action.Path]),
+				FileName: path.Base(action.Path),
+			}, WithRequestContext(ctx))
+			if err != nil {
+				return plan[:i], fmt.Errorf("failed to upload %s: %w", action.Path, err)
+			}
+			plan[i].Document = doc
+		}
+		if action.Previous.ID != "" {
+			if err := c.DeleteKnowledgeBaseDocument(action.Previous.ID, WithRequestContext(ctx)); err != nil {
+				if action.Action == KnowledgeBaseSyncReplace {
+					i++ // The new version was uploaded nonetheless
+				}
+				return plan[:i], fmt.Errorf("failed to delete previous document of %s: %w", action.Path, err)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// syncedKnowledgeBaseDocuments returns the documents named by SyncKnowledgeBase under prefix, by file path. A
+// path has several documents when a previous sync was interrupted.
+func (c *Client) syncedKnowledgeBaseDocuments(ctx context.Context, prefix string) (map[string][]KnowledgeBaseDocument, error) {
+	docs := map[string][]KnowledgeBaseDocument{}
+	var queries []QueryFunc
+	for {
+		docsResp, err := c.getKnowledgeBaseDocuments(ctx, queries)
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docsResp.Documents {
+			if p, ok := syncedDocumentPath(prefix, doc.Name); ok {
+				docs[p] = append(docs[p], doc)
+			}
+		}
+		if !docsResp.HasMore {
+			return docs, nil
+		}
+		queries = []QueryFunc{Cursor(docsResp.NextCursor)}
+	}
+}
+
+func syncedDocumentName(prefix, p string, content []byte) string {
+	sum := sha256.Sum256(content)
+	return fmt.Sprintf("%s%s #%s", prefix, p, hex.EncodeToString(sum[:8]))
+}
+
+func syncedDocumentPath(prefix, name string) (string, bool) {
+	if !strings.HasPrefix(name, prefix) {
+		return "", false
+	}
+	i := strings.LastIndex(name, " #")
+	if i < len(prefix) || len(name)-i-2 != 16 {
+		return "", false
+	}
+	if _, err := hex.DecodeString(name[i+2:]); err != nil {
+		return "", false
+	}
+	return name[len(prefix):i], true
+}
@@ -0,0 +1,247 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestKnowledgeBaseDocuments(t *testing.T) {
+	indexPolls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "POST /convai/knowledge-base/file":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse multipart form: %v", err)
+				return
+			}
+			f, fh, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("Server: expected file: %v", err)
+				return
+			}
+			content, _ := io.ReadAll(f)
+			if fh.Filename != "faq.md" || string(content) != "# FAQ" || r.FormValue("name") != "FAQ" {
+				t.Errorf("Server: unexpected upload %q %q %v", fh.Filename, content, r.MultipartForm.Value)
+			}
+			w.Write([]byte(`{"id": "d1", "name": "FAQ"}`))
+		case "POST /convai/knowledge-base/url", "POST /convai/knowledge-base/text":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["url"] != "https://example.com" && body["text"] != "Opening hours: 9-5" {
+				t.Errorf("Server: unexpected body %v", body)
+			}
+			w.Write([]byte(`{"id": "d2", "name": "` + body["name"] + `"}`))
+		case "GET /convai/knowledge-base":
+			if r.URL.RawQuery != "search=FAQ" {
+				t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"documents": [{"id": "d1", "name": "FAQ", "type": "file", "metadata": {"size_bytes": 5}}], "has_more": false}`))
+		case "GET /convai/knowledge-base/d2":
+			w.Write([]byte(`{"id": "d2", "name": "Site", "type": "url", "url": "https://example.com"}`))
+		case "DELETE /convai/knowledge-base/d2":
+		case "POST /convai/knowledge-base/d1/rag-index":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			status := elevenlabs.RAGIndexStatusProcessing
+			if indexPolls++; indexPolls > 2 {
+				status = elevenlabs.RAGIndexStatusSucceeded
+			}
+			json.NewEncoder(w).Encode(elevenlabs.RAGIndex{ID: "i1", Model: body["model"], Status: status})
+		case "POST /convai/knowledge-base/d3/rag-index":
+			w.Write([]byte(`{"id": "i3", "status": "rag_limit_exceeded"}`))
+		default:
+			t.Errorf("Server: unexpected request %q", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	doc, err := client.AddKnowledgeBaseFile(elevenlabs.AddKnowledgeBaseFileRequest{Name: "FAQ", File: strings.NewReader("# FAQ"), FileName: "faq.md"})
+	if err != nil {
+		t.Fatalf("Expected no errors uploading, got error: %q", err)
+	}
+	if loc := doc.Locator(); loc != (elevenlabs.KnowledgeBaseLocator{Type: elevenlabs.KnowledgeBaseTypeFile, Name: "FAQ", ID: "d1"}) {
+		t.Errorf("Unexpected locator: %+v", loc)
+	}
+	if doc, err := client.AddKnowledgeBaseURL("https://example.com", "Site"); err != nil || doc.Type != elevenlabs.KnowledgeBaseTypeURL {
+		t.Errorf("Unexpected URL document: %+v, %v", doc, err)
+	}
+	if doc, err := client.AddKnowledgeBaseText("Opening hours: 9-5", "Hours"); err != nil || doc.Type != elevenlabs.KnowledgeBaseTypeText {
+		t.Errorf("Unexpected text document: %+v, %v", doc, err)
+	}
+
+	docsResp, nextPage, err := client.GetKnowledgeBaseDocuments(elevenlabs.Search("FAQ"))
+	if err != nil || nextPage != nil || len(docsResp.Documents) != 1 || docsResp.Documents[0].Metadata.SizeBytes != 5 {
+		t.Errorf("Unexpected documents: %+v, %v", docsResp, err)
+	}
+	if doc, err := client.GetKnowledgeBaseDocument("d2"); err != nil || doc.URL != "https://example.com" {
+		t.Errorf("Unexpected document: %+v, %v", doc, err)
+	}
+	if err := client.DeleteKnowledgeBaseDocument("d2"); err != nil {
+		t.Errorf("Expected no errors deleting, got error: %q", err)
+	}
+
+	index, err := client.WaitForRAGIndex(context.Background(), "d1", elevenlabs.RAGEmbeddingModelE5Mistral, time.Millisecond)
+	if err != nil || index.Status != elevenlabs.RAGIndexStatusSucceeded || index.Model != elevenlabs.RAGEmbeddingModelE5Mistral {
+		t.Errorf("Unexpected RAG index: %+v, %v", index, err)
+	}
+	if _, err := client.WaitForRAGIndex(context.Background(), "d3", elevenlabs.RAGEmbeddingModelE5Mistral, time.Millisecond); !errors.Is(err, elevenlabs.ErrRAGIndexFailed) {
+		t.Errorf("Expected ErrRAGIndexFailed, got %v", err)
+	}
+}
+
+// testKnowledgeBase is an in-memory stand-in of the knowledge base API.
+type testKnowledgeBase struct {
+	t      *testing.T
+	mu     sync.Mutex
+	docs   []elevenlabs.KnowledgeBaseDocument
+	nextID int
+}
+
+func (kb *testKnowledgeBase) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/convai/knowledge-base":
+		// Serve one document per page to exercise pagination.
+		i := 0
+		if c := r.URL.Query().Get("cursor"); c != "" {
+			fmt.Sscan(c, &i)
+		}
+		resp := elevenlabs.GetKnowledgeBaseDocumentsResponse{}
+		if i < len(kb.docs) {
+			resp.Documents = kb.docs[i : i+1]
+		}
+		if i+1 < len(kb.docs) {
+			resp.HasMore, resp.NextCursor = true, fmt.Sprint(i+1)
+		}
+		json.NewEncoder(w).Encode(resp)
+	case r.Method == http.MethodPost && r.URL.Path == "/convai/knowledge-base/file":
+		kb.nextID++
+		doc := elevenlabs.KnowledgeBaseDocument{ID: fmt.Sprintf("d%d", kb.nextID), Name: r.FormValue("name"), Type: "file"}
+		kb.docs = append(kb.docs, doc)
+		json.NewEncoder(w).Encode(doc)
+	case r.Method == http.MethodDelete:
+		for i, doc := range kb.docs {
+			if r.URL.Path == "/convai/knowledge-base/"+doc.ID {
+				kb.docs = append(kb.docs[:i], kb.docs[i+1:]...)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		kb.t.Errorf("Server: unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestSyncKnowledgeBase(t *testing.T) {
+	kb := &testKnowledgeBase{t: t, docs: []elevenlabs.KnowledgeBaseDocument{{ID: "manual", Name: "Pricing"}}}
+	server := httptest.NewServer(kb)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	summarize := func(actions []elevenlabs.KnowledgeBaseSyncAction) []string {
+		var s []string
+		for _, a := range actions {
+			s = append(s, a.Action+" "+a.Path)
+		}
+		return s
+	}
+
+	dir := fstest.MapFS{
+		"faq.md":       {Data: []byte("# FAQ")},
+		"hr/leave.md":  {Data: []byte("Leave policy")},
+		"hr/remote.md": {Data: []byte("Remote policy")},
+	}
+	options := elevenlabs.KnowledgeBaseSyncOptions{Prefix: "handbook/", Prune: true}
+	actions, err := client.SyncKnowledgeBase(context.Background(), dir, options)
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if exp := []string{"upload faq.md", "upload hr/leave.md", "upload hr/remote.md"}; !reflect.DeepEqual(summarize(actions), exp) {
+		t.Errorf("Expected actions %v, got %v", exp, summarize(actions))
+	}
+	if actions[0].Document.ID == "" || actions[0].Document.Name != "handbook/faq.md #3a80b1c867cf3794" {
+		t.Errorf("Unexpected uploaded document: %+v", actions[0].Document)
+	}
+
+	if actions, err := client.SyncKnowledgeBase(context.Background(), dir, options); err != nil || len(actions) != 0 {
+		t.Errorf("Expected unchanged directory to need no actions, got %v, %v", summarize(actions), err)
+	}
+
+	dir["faq.md"] = &fstest.MapFile{Data: []byte("# FAQ v2")}
+	delete(dir, "hr/remote.md")
+	options.DryRun = true
+	actions, err = client.SyncKnowledgeBase(context.Background(), dir, options)
+	if exp := []string{"replace faq.md", "delete hr/remote.md"}; err != nil || !reflect.DeepEqual(summarize(actions), exp) {
+		t.Errorf("Expected dry-run actions %v, got %v, %v", exp, summarize(actions), err)
+	}
+	if len(kb.docs) != 4 {
+		t.Errorf("Expected dry run to leave the 4 documents untouched, got %+v", kb.docs)
+	}
+
+	options.DryRun = false
+	if _, err := client.SyncKnowledgeBase(context.Background(), dir, options); err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	var names []string
+	for _, doc := range kb.docs {
+		names = append(names, doc.Name)
+	}
+	if len(names) != 3 || names[0] != "Pricing" || names[2][:len("handbook/faq.md")] != "handbook/faq.md" {
+		t.Errorf("Unexpected knowledge base after sync: %v", names)
+	}
+}
+
+func TestSyncKnowledgeBaseLeftovers(t *testing.T) {
+	// Interrupted syncs left an old version of faq.md next to the current one, and two old versions of leave.md.
+	kb := &testKnowledgeBase{t: t, docs: []elevenlabs.KnowledgeBaseDocument{
+		{ID: "old", Name: "handbook/faq.md #0000000000000000"},
+		{ID: "current", Name: "handbook/faq.md #3a80b1c867cf3794"},
+		{ID: "stale1", Name: "handbook/leave.md #1111111111111111"},
+		{ID: "stale2", Name: "handbook/leave.md #2222222222222222"},
+	}}
+	server := httptest.NewServer(kb)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	dir := fstest.MapFS{
+		"faq.md":   {Data: []byte("# FAQ")},
+		"leave.md": {Data: []byte("Leave policy")},
+	}
+	options := elevenlabs.KnowledgeBaseSyncOptions{Prefix: "handbook/"}
+	actions, err := client.SyncKnowledgeBase(context.Background(), dir, options)
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	var summary []string
+	for _, a := range actions {
+		summary = append(summary, a.Action+" "+a.Path+" "+a.Previous.ID)
+	}
+	if exp := []string{"delete faq.md old", "replace leave.md stale1", "delete leave.md stale2"}; !reflect.DeepEqual(summary, exp) {
+		t.Errorf("Expected actions %v, got %v", exp, summary)
+	}
+	if len(kb.docs) != 2 || kb.docs[0].ID != "current" {
+		t.Errorf("Expected a single document per file, got %+v", kb.docs)
+	}
+
+	if actions, err := client.SyncKnowledgeBase(context.Background(), dir, options); err != nil || len(actions) != 0 {
+		t.Errorf("Expected no actions once leftovers are deleted, got %v, %v", actions, err)
+	}
+}
@@ -2,12 +2,20 @@ package elevenlabs
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Language struct {
@@ -33,9 +41,10 @@ type Model struct {
 }
 
 type TextToSpeechRequest struct {
-	Text          string         `json:"text"`
-	ModelID       string         `json:"model_id,omitempty"`
-	VoiceSettings *VoiceSettings `json:"voice_settings,omitempty"`
+	Text                            string                           `json:"text"`
+	ModelID                         string                           `json:"model_id,omitempty"`
+	VoiceSettings                   *VoiceSettings                   `json:"voice_settings,omitempty"`
+	PronunciationDictionaryLocators []PronunciationDictionaryLocator `json:"pronunciation_dictionary_locators,omitempty"` // Up to 3, applied in order
 }
 
 type GetVoicesResponse struct {
@@ -61,6 +70,65 @@ type Voice struct {
 	VoiceId                 string            `json:"voice_id"`
 }
 
+// Voice types of VoiceSearchFilter.
+const (
+	VoiceTypePersonal   = "personal"
+	VoiceTypeCommunity  = "community"
+	VoiceTypeDefault    = "default"
+	VoiceTypeWorkspace  = "workspace"
+	VoiceTypeNonDefault = "non-default"
+)
+
+// Sort orders of VoiceSearchFilter.
+const (
+	VoiceSortCreatedAt = "created_at_unix"
+	VoiceSortName      = "name"
+)
+
+// VoiceSearchFilter represents the search criteria of SearchVoices and IterateVoices. Empty fields match all
+// voices.
+type VoiceSearchFilter struct {
+	Search        string // Matched against the name, description, labels and category of the voices
+	Category      string // e.g. "premade", "cloned", "generated" or "professional"
+	VoiceType     string // One of the VoiceType constants
+	Sort          string // One of the VoiceSort constants
+	SortDirection string // "asc" or "desc"
+	PageSize      int    // Up to 100. Defaults to 10 server-side.
+}
+
+func (f *VoiceSearchFilter) queries() []QueryFunc {
+	var queries []QueryFunc
+	add := func(key, value string) {
+		queries = append(queries, func(q *url.Values) { q.Add(key, value) })
+	}
+	if f.Search != "" {
+		add("search", f.Search)
+	}
+	if f.Category != "" {
+		add("category", f.Category)
+	}
+	if f.VoiceType != "" {
+		add("voice_type", f.VoiceType)
+	}
+	if f.Sort != "" {
+		add("sort", f.Sort)
+	}
+	if f.SortDirection != "" {
+		add("sort_direction", f.SortDirection)
+	}
+	if f.PageSize > 0 {
+		queries = append(queries, PageSize(f.PageSize))
+	}
+	return queries
+}
+
+type SearchVoicesResponse struct {
+	Voices        []Voice `json:"voices"`
+	HasMore       bool    `json:"has_more"`
+	TotalCount    int     `json:"total_count"`
+	NextPageToken string  `json:"next_page_token"`
+}
+
 type VoiceSettings struct {
 	SimilarityBoost float32 `json:"similarity_boost"`
 	Stability       float32 `json:"stability"`
@@ -219,10 +287,20 @@ type User struct {
 }
 
 type AddEditVoiceRequest struct {
-	Name        string
-	FilePaths   []string
-	Description string
-	Labels      map[string]string
+	Name                  string
+	FilePaths             []string
+	Files                 []SampleFile // Samples read from memory or streams, sent after the ones of FilePaths
+	Description           string
+	Labels                map[string]string
+	RemoveBackgroundNoise bool // Remove the background noise of the samples with audio isolation
+	ClearEmpty            bool // Send an empty Description and Labels, to clear those of the voice being edited
+}
+
+// SampleFile represents an audio sample that isn't read from the file system, such as one generated in memory.
+type SampleFile struct {
+	FileName string
+	MimeType string // e.g. "audio/mpeg". Defaults to "application/octet-stream".
+	Content  io.Reader
 }
 
 func (r *AddEditVoiceRequest) buildRequestBody() (*bytes.Buffer, string, error) {
@@ -235,13 +313,17 @@ func (r *AddEditVoiceRequest) buildRequestBody() (*bytes.Buffer, string, error)
 	if err := w.WriteField("name", r.Name); err != nil {
 		return buildFailed(err)
 	}
-	if r.Description != "" {
+	if r.Description != "" || r.ClearEmpty {
 		if err := w.WriteField("description", r.Description); err != nil {
 			return buildFailed(err)
 		}
 	}
-	if len(r.Labels) > 0 {
-		labelsJson, err := json.Marshal(r.Labels)
+	if len(r.Labels) > 0 || r.ClearEmpty {
+		labels := r.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labelsJson, err := json.Marshal(labels)
 		if err != nil {
 			return buildFailed(err)
 		}
@@ -249,19 +331,19 @@ func (r *AddEditVoiceRequest) buildRequestBody() (*bytes.Buffer, string, error)
 			return buildFailed(err)
 		}
 	}
-
-	for _, file := range r.FilePaths {
-		f, err := os.Open(file)
-		if err != nil {
+	if r.RemoveBackgroundNoise {
+		if err := w.WriteField("remove_background_noise", "true"); err != nil {
 			return buildFailed(err)
 		}
-		defer f.Close()
+	}
 
-		fw, err := w.CreateFormFile("files", filepath.Base(file))
-		if err != nil {
+	for _, path := range r.FilePaths {
+		if err := writeFormFile(w, "files", path); err != nil {
 			return buildFailed(err)
 		}
-		if _, err = io.Copy(fw, f); err != nil {
+	}
+	for _, file := range r.Files {
+		if err := writeSampleFile(w, "files", file); err != nil {
 			return buildFailed(err)
 		}
 	}
@@ -274,6 +356,30 @@ func (r *AddEditVoiceRequest) buildRequestBody() (*bytes.Buffer, string, error)
 	return &b, w.FormDataContentType(), nil
 }
 
+// formQuoteEscaper escapes the quoted parameters of Content-Disposition headers like mime/multipart does.
+var formQuoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// writeSampleFile copies the content of file to a new form file of w with the MIME type of file.
+func writeSampleFile(w *multipart.Writer, fieldName string, file SampleFile) error {
+	if file.Content == nil {
+		return fmt.Errorf("sample %q has no content", file.FileName)
+	}
+	mimeType := file.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, fieldName, formQuoteEscaper.Replace(file.FileName)))
+	h.Set("Content-Type", mimeType)
+	fw, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, file.Content)
+	return err
+}
+
 // SpeechToTextRequest represents the request parameters for speech-to-text conversion
 type SpeechToTextRequest struct {
 	ModelID               string               `json:"model_id"`
@@ -325,6 +431,40 @@ type SpeechToTextResponse struct {
 	Words               []SpeechToTextWord `json:"words"`
 }
 
+// SpeakerUtterance represents a stretch of speech by a single speaker, with its start and end times in seconds.
+// It is the diarization-style view shared by speech-to-text results and conversation transcripts.
+type SpeakerUtterance struct {
+	SpeakerID string
+	Text      string
+	Start     float64
+	End       float64
+}
+
+// Utterances groups the words of the transcription into consecutive utterances by speaker. Words without a
+// speaker ID, as returned when diarization is disabled, form utterances with an empty SpeakerID.
+func (r SpeechToTextResponse) Utterances() []SpeakerUtterance {
+	var utterances []SpeakerUtterance
+	for _, w := range r.Words {
+		speakerID := ""
+		if w.SpeakerID != nil {
+			speakerID = *w.SpeakerID
+		}
+		if n := len(utterances); n > 0 && utterances[n-1].SpeakerID == speakerID {
+			utterances[n-1].Text += w.Text
+			utterances[n-1].End = w.End
+			continue
+		}
+		if w.Type == "spacing" {
+			continue
+		}
+		utterances = append(utterances, SpeakerUtterance{SpeakerID: speakerID, Text: w.Text, Start: w.Start, End: w.End})
+	}
+	for i := range utterances {
+		utterances[i].Text = strings.TrimSpace(utterances[i].Text)
+	}
+	return utterances
+}
+
 // MultichannelSpeechToTextResponse represents the response for multi-channel audio
 type MultichannelSpeechToTextResponse struct {
 	Transcripts []SpeechToTextResponse `json:"transcripts"`
@@ -466,3 +606,1642 @@ func (r *SpeechToTextRequest) buildRequestBody() (*bytes.Buffer, string, error)
 
 	return &b, w.FormDataContentType(), nil
 }
+
+// Commit strategies supported by the realtime speech-to-text endpoint.
+const (
+	// RealtimeCommitManual leaves committing transcripts to the caller (see RealtimeSpeechToTextSession.Commit).
+	RealtimeCommitManual = "manual"
+	// RealtimeCommitVAD lets the server commit transcripts when voice activity detection detects silence.
+	RealtimeCommitVAD = "vad"
+)
+
+// Message types of transcripts received from the realtime speech-to-text endpoint.
+const (
+	RealtimePartialTranscript                 = "partial_transcript"
+	RealtimeCommittedTranscript               = "committed_transcript"
+	RealtimeCommittedTranscriptWithTimestamps = "committed_transcript_with_timestamps"
+)
+
+// RealtimeSpeechToTextConfig represents the settings of a realtime speech-to-text session. They are sent as
+// query parameters when the WebSocket connection is opened and cannot be changed for the lifetime of the session.
+type RealtimeSpeechToTextConfig struct {
+	ModelID                 string
+	AudioFormat             string // e.g. pcm_16000 or ulaw_8000. Defaults to pcm_16000 server-side.
+	LanguageCode            *string
+	CommitStrategy          string // RealtimeCommitManual or RealtimeCommitVAD.
+	VADSilenceThresholdSecs *float64
+	VADThreshold            *float64
+	MinSpeechDurationMs     *int
+	MinSilenceDurationMs    *int
+	IncludeTimestamps       *bool
+}
+
+func (r *RealtimeSpeechToTextConfig) queries() []QueryFunc {
+	var queries []QueryFunc
+	set := func(key, value string) {
+		queries = append(queries, func(q *url.Values) {
+			q.Set(key, value)
+		})
+	}
+	if r.ModelID != "" {
+		set("model_id", r.ModelID)
+	}
+	if r.AudioFormat != "" {
+		set("audio_format", r.AudioFormat)
+	}
+	if r.LanguageCode != nil {
+		set("language_code", *r.LanguageCode)
+	}
+	if r.CommitStrategy != "" {
+		set("commit_strategy", r.CommitStrategy)
+	}
+	if r.VADSilenceThresholdSecs != nil {
+		set("vad_silence_threshold_secs", fmt.Sprint(*r.VADSilenceThresholdSecs))
+	}
+	if r.VADThreshold != nil {
+		set("vad_threshold", fmt.Sprint(*r.VADThreshold))
+	}
+	if r.MinSpeechDurationMs != nil {
+		set("min_speech_duration_ms", fmt.Sprint(*r.MinSpeechDurationMs))
+	}
+	if r.MinSilenceDurationMs != nil {
+		set("min_silence_duration_ms", fmt.Sprint(*r.MinSilenceDurationMs))
+	}
+	if r.IncludeTimestamps != nil {
+		set("include_timestamps", fmt.Sprintf("%t", *r.IncludeTimestamps))
+	}
+	return queries
+}
+
+// RealtimeTranscript represents a partial or committed transcript received during a realtime speech-to-text session.
+//
+// Words are only populated for committed transcripts when timestamps were requested with IncludeTimestamps.
+type RealtimeTranscript struct {
+	MessageType  string             `json:"message_type"`
+	Text         string             `json:"text"`
+	LanguageCode string             `json:"language_code,omitempty"`
+	Words        []SpeechToTextWord `json:"words,omitempty"`
+}
+
+// Committed reports whether the transcript is final, as opposed to a partial transcript that may still change.
+func (t RealtimeTranscript) Committed() bool {
+	return t.MessageType == RealtimeCommittedTranscript || t.MessageType == RealtimeCommittedTranscriptWithTimestamps
+}
+
+// ForcedAlignmentRequest represents the request parameters for aligning an audio file with a known transcript.
+type ForcedAlignmentRequest struct {
+	File               io.Reader // Audio or video content, handled separately in multipart
+	FileName           string    // Original filename for multipart
+	Text               string    // The transcript to align with the audio
+	EnabledSpooledFile *bool     // Stream the file server-side, which is useful for very large files
+}
+
+// ForcedAlignmentCharacter represents a character of the transcript with its timing in the audio.
+type ForcedAlignmentCharacter struct {
+	Text  string  `json:"text"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// ForcedAlignmentWord represents a word of the transcript with its timing in the audio and the
+// alignment loss for that word. A lower loss indicates a more confident alignment.
+type ForcedAlignmentWord struct {
+	Text  string  `json:"text"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+	Loss  float64 `json:"loss"`
+}
+
+// ForcedAlignmentResponse represents the response from forced alignment.
+type ForcedAlignmentResponse struct {
+	Characters []ForcedAlignmentCharacter `json:"characters"`
+	Words      []ForcedAlignmentWord      `json:"words"`
+	Loss       float64                    `json:"loss"`
+}
+
+// SpeechToTextWords converts the aligned words to SpeechToTextWord values so that they can be used
+// interchangeably with speech-to-text transcription results.
+func (r ForcedAlignmentResponse) SpeechToTextWords() []SpeechToTextWord {
+	words := make([]SpeechToTextWord, len(r.Words))
+	for i, w := range r.Words {
+		words[i] = SpeechToTextWord{
+			Text:  w.Text,
+			Start: w.Start,
+			End:   w.End,
+			Type:  "word",
+		}
+	}
+	return words
+}
+
+// buildRequestBody creates the multipart form request body for forced alignment
+func (r *ForcedAlignmentRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build forced alignment request body: %w", err)
+	}
+
+	if r.File != nil {
+		fw, err := w.CreateFormFile("file", r.FileName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, r.File); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if err := w.WriteField("text", r.Text); err != nil {
+		return buildFailed(err)
+	}
+
+	if r.EnabledSpooledFile != nil {
+		if err := w.WriteField("enabled_spooled_file", fmt.Sprintf("%t", *r.EnabledSpooledFile)); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+// ResponseMetadata represents information returned in the headers of audio generating endpoints.
+type ResponseMetadata struct {
+	RequestID     string
+	ContentType   string
+	CharacterCost int // Number of characters billed for the request, if reported by the server.
+}
+
+func responseMetadataFromHeader(h http.Header) ResponseMetadata {
+	meta := ResponseMetadata{
+		RequestID:   h.Get("request-id"),
+		ContentType: h.Get("Content-Type"),
+	}
+	if cost, err := strconv.Atoi(h.Get("character-cost")); err == nil {
+		meta.CharacterCost = cost
+	}
+	return meta
+}
+
+// SoundEffectRequest represents the request parameters for generating a sound effect from a text prompt.
+type SoundEffectRequest struct {
+	Text            string   `json:"text"`
+	ModelID         string   `json:"model_id,omitempty"`
+	DurationSeconds *float64 `json:"duration_seconds,omitempty"` // Between 0.5 and 30. Guessed from the prompt if nil.
+	PromptInfluence *float64 `json:"prompt_influence,omitempty"` // Between 0 and 1. Higher values follow the prompt more closely.
+	Loop            bool     `json:"loop,omitempty"`             // Create a sound effect that loops smoothly.
+}
+
+// SpeechToSpeechRequest represents the request parameters for converting recorded speech to a different voice.
+type SpeechToSpeechRequest struct {
+	Audio                 io.Reader      // Audio content, handled separately in multipart
+	FileName              string         // Original filename for multipart
+	ModelID               string         // Must be a model that supports voice conversion (see Model.CanDoVoiceConversion)
+	VoiceSettings         *VoiceSettings // Overrides the stored settings of the target voice for this request only
+	Seed                  *int
+	RemoveBackgroundNoise *bool
+	FileFormat            *string // "pcm_s16le_16" for raw 16-bit PCM at 16kHz, or "other" (default) for encoded audio
+}
+
+// buildRequestBody creates the multipart form request body for speech-to-speech
+func (r *SpeechToSpeechRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build speech-to-speech request body: %w", err)
+	}
+
+	if r.Audio != nil {
+		fw, err := w.CreateFormFile("audio", r.FileName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, r.Audio); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.ModelID != "" {
+		if err := w.WriteField("model_id", r.ModelID); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.VoiceSettings != nil {
+		settingsJSON, err := json.Marshal(r.VoiceSettings)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if err := w.WriteField("voice_settings", string(settingsJSON)); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.Seed != nil {
+		if err := w.WriteField("seed", fmt.Sprintf("%d", *r.Seed)); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.RemoveBackgroundNoise != nil {
+		if err := w.WriteField("remove_background_noise", fmt.Sprintf("%t", *r.RemoveBackgroundNoise)); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.FileFormat != nil {
+		if err := w.WriteField("file_format", *r.FileFormat); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+// AudioIsolationRequest represents the request parameters for removing background noise from audio.
+type AudioIsolationRequest struct {
+	Audio      io.Reader // Audio content, handled separately in multipart
+	FileName   string    // Original filename for multipart
+	FileFormat *string   // "pcm_s16le_16" for raw 16-bit PCM at 16kHz, or "other" (default) for encoded audio
+}
+
+// buildRequestBody creates the multipart form request body for audio isolation
+func (r *AudioIsolationRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build audio isolation request body: %w", err)
+	}
+
+	if r.Audio != nil {
+		fw, err := w.CreateFormFile("audio", r.FileName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, r.Audio); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.FileFormat != nil {
+		if err := w.WriteField("file_format", *r.FileFormat); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+// Statuses of a dubbing project as reported in DubbingMetadata.
+const (
+	DubbingStatusDubbing = "dubbing"
+	DubbingStatusDubbed  = "dubbed"
+	DubbingStatusFailed  = "failed"
+)
+
+// DubbingRequest represents the request parameters for creating a new dubbing project from a file or a URL.
+type DubbingRequest struct {
+	File                io.Reader // Audio or video content, handled separately in multipart
+	FileName            string    // Original filename for multipart
+	SourceURL           string    // URL of the source video or audio, used instead of File
+	Name                string
+	SourceLang          string // Defaults to "auto" detection server-side
+	TargetLang          string
+	NumSpeakers         int // Number of speakers, or 0 to detect automatically
+	Watermark           *bool
+	StartTime           *int // Start of the section to dub, in seconds
+	EndTime             *int // End of the section to dub, in seconds
+	HighestResolution   *bool
+	DropBackgroundAudio *bool
+	UseProfanityFilter  *bool
+	DubbingStudio       *bool // Prepare the project for editing, which is required to edit segments
+}
+
+// CreateDubbingResponse represents the response returned when a dubbing project is created.
+type CreateDubbingResponse struct {
+	DubbingID           string  `json:"dubbing_id"`
+	ExpectedDurationSec float64 `json:"expected_duration_sec"`
+}
+
+// DubbingMetadata represents the state of a dubbing project.
+type DubbingMetadata struct {
+	DubbingID       string   `json:"dubbing_id"`
+	Name            string   `json:"name"`
+	Status          string   `json:"status"`
+	TargetLanguages []string `json:"target_languages"`
+	Error           string   `json:"error,omitempty"`
+}
+
+// DubbingResource represents the editable transcript of a dubbing project created with DubbingStudio set.
+type DubbingResource struct {
+	ID              string                    `json:"id"`
+	Version         int                       `json:"version"`
+	SourceLanguage  string                    `json:"source_language"`
+	TargetLanguages []string                  `json:"target_languages"`
+	SpeakerSegments map[string]DubbingSegment `json:"speaker_segments"`
+}
+
+// DubbingSegment represents a section of speech in the source media and its translations, keyed by language code.
+type DubbingSegment struct {
+	ID        string                       `json:"id"`
+	StartTime float64                      `json:"start_time"`
+	EndTime   float64                      `json:"end_time"`
+	Text      string                       `json:"text"`
+	Dubs      map[string]DubbingSegmentDub `json:"dubs"`
+}
+
+// DubbingSegmentDub represents the translation of a DubbingSegment into one of the target languages.
+type DubbingSegmentDub struct {
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+	Text      string  `json:"text"`
+}
+
+// DubbingSegmentUpdate represents changes to the timing or text of a segment for one language. Nil fields are left unchanged.
+type DubbingSegmentUpdate struct {
+	StartTime *float64 `json:"start_time,omitempty"`
+	EndTime   *float64 `json:"end_time,omitempty"`
+	Text      *string  `json:"text,omitempty"`
+}
+
+// buildRequestBody creates the multipart form request body for dubbing
+func (r *DubbingRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build dubbing request body: %w", err)
+	}
+
+	if r.File != nil {
+		fw, err := w.CreateFormFile("file", r.FileName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, r.File); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	fields := [][2]string{
+		{"source_url", r.SourceURL},
+		{"name", r.Name},
+		{"source_lang", r.SourceLang},
+		{"target_lang", r.TargetLang},
+	}
+	if r.NumSpeakers > 0 {
+		fields = append(fields, [2]string{"num_speakers", fmt.Sprint(r.NumSpeakers)})
+	}
+	if r.Watermark != nil {
+		fields = append(fields, [2]string{"watermark", fmt.Sprintf("%t", *r.Watermark)})
+	}
+	if r.StartTime != nil {
+		fields = append(fields, [2]string{"start_time", fmt.Sprint(*r.StartTime)})
+	}
+	if r.EndTime != nil {
+		fields = append(fields, [2]string{"end_time", fmt.Sprint(*r.EndTime)})
+	}
+	if r.HighestResolution != nil {
+		fields = append(fields, [2]string{"highest_resolution", fmt.Sprintf("%t", *r.HighestResolution)})
+	}
+	if r.DropBackgroundAudio != nil {
+		fields = append(fields, [2]string{"drop_background_audio", fmt.Sprintf("%t", *r.DropBackgroundAudio)})
+	}
+	if r.UseProfanityFilter != nil {
+		fields = append(fields, [2]string{"use_profanity_filter", fmt.Sprintf("%t", *r.UseProfanityFilter)})
+	}
+	if r.DubbingStudio != nil {
+		fields = append(fields, [2]string{"dubbing_studio", fmt.Sprintf("%t", *r.DubbingStudio)})
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+// States of a Studio project or chapter.
+const (
+	ProjectStateDefault    = "default"
+	ProjectStateConverting = "converting"
+	ProjectStateInQueue    = "in_queue"
+)
+
+// CreateProjectRequest represents the request parameters for creating a Studio project for long-form audio such as
+// audiobooks. The initial content is taken from one of FromDocument, FromURL or FromText.
+type CreateProjectRequest struct {
+	Name                    string
+	DefaultTitleVoiceID     string
+	DefaultParagraphVoiceID string
+	DefaultModelID          string
+	FromDocument            io.Reader // EPUB, PDF, DOCX, TXT or HTML content, handled separately in multipart
+	DocumentName            string    // Original filename of FromDocument, whose extension determines the document type
+	FromURL                 string    // URL of a web page whose article is extracted as the project content
+	FromText                string    // Plain text uploaded as a TXT document
+	QualityPreset           string    // e.g. "standard", "high", "ultra" or "ultra_lossless"
+	Title                   string
+	Author                  string
+	Language                string
+	AutoConvert             *bool // Start converting the project as soon as it's created
+}
+
+// Project represents a Studio project.
+type Project struct {
+	ProjectID               string    `json:"project_id"`
+	Name                    string    `json:"name"`
+	CreateDateUnix          int       `json:"create_date_unix"`
+	DefaultTitleVoiceID     string    `json:"default_title_voice_id"`
+	DefaultParagraphVoiceID string    `json:"default_paragraph_voice_id"`
+	DefaultModelID          string    `json:"default_model_id"`
+	LastConversionDateUnix  int       `json:"last_conversion_date_unix"`
+	CanBeDownloaded         bool      `json:"can_be_downloaded"`
+	Title                   string    `json:"title"`
+	Author                  string    `json:"author"`
+	Language                string    `json:"language"`
+	QualityPreset           string    `json:"quality_preset"`
+	State                   string    `json:"state"`
+	Chapters                []Chapter `json:"chapters,omitempty"` // Only populated by GetProject
+}
+
+// Chapter represents a chapter of a Studio project.
+type Chapter struct {
+	ChapterID              string          `json:"chapter_id"`
+	Name                   string          `json:"name"`
+	LastConversionDateUnix int             `json:"last_conversion_date_unix"`
+	ConversionProgress     float64         `json:"conversion_progress"`
+	CanBeDownloaded        bool            `json:"can_be_downloaded"`
+	State                  string          `json:"state"`
+	Content                *ChapterContent `json:"content,omitempty"` // Only populated by GetChapter
+}
+
+// ChapterContent represents the text of a chapter as a list of blocks, each being a title or a paragraph.
+type ChapterContent struct {
+	Blocks []ChapterContentBlock `json:"blocks"`
+}
+
+// ChapterContentBlock represents a paragraph or heading of a chapter.
+type ChapterContentBlock struct {
+	BlockID string               `json:"block_id,omitempty"`
+	SubType string               `json:"sub_type,omitempty"` // "p", "h1", "h2" or "h3"
+	Nodes   []ChapterContentNode `json:"nodes"`
+}
+
+// ChapterContentNode represents a run of text within a block and the voice it is read with.
+type ChapterContentNode struct {
+	Type    string `json:"type"` // "tts_node" for text read by a voice
+	VoiceID string `json:"voice_id,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// ProjectSnapshot represents a converted version of a whole Studio project.
+type ProjectSnapshot struct {
+	ProjectSnapshotID string `json:"project_snapshot_id"`
+	ProjectID         string `json:"project_id"`
+	CreatedAtUnix     int    `json:"created_at_unix"`
+	Name              string `json:"name"`
+}
+
+// ChapterSnapshot represents a converted version of a single chapter.
+type ChapterSnapshot struct {
+	ChapterSnapshotID string `json:"chapter_snapshot_id"`
+	ProjectID         string `json:"project_id"`
+	ChapterID         string `json:"chapter_id"`
+	CreatedAtUnix     int    `json:"created_at_unix"`
+	Name              string `json:"name"`
+}
+
+type GetProjectsResponse struct {
+	Projects   []Project `json:"projects"`
+	HasMore    bool      `json:"has_more"`
+	NextCursor string    `json:"next_cursor"`
+}
+
+type GetChaptersResponse struct {
+	Chapters   []Chapter `json:"chapters"`
+	HasMore    bool      `json:"has_more"`
+	NextCursor string    `json:"next_cursor"`
+}
+
+type GetProjectSnapshotsResponse struct {
+	Snapshots []ProjectSnapshot `json:"snapshots"`
+}
+
+type GetChapterSnapshotsResponse struct {
+	Snapshots []ChapterSnapshot `json:"snapshots"`
+}
+
+type EditChapterRequest struct {
+	Name    string          `json:"name,omitempty"`
+	Content *ChapterContent `json:"content,omitempty"`
+}
+
+// buildRequestBody creates the multipart form request body for creating a project
+func (r *CreateProjectRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build project request body: %w", err)
+	}
+
+	document, documentName := r.FromDocument, r.DocumentName
+	if document == nil && r.FromText != "" {
+		document, documentName = strings.NewReader(r.FromText), "content.txt"
+	}
+	if document != nil {
+		fw, err := w.CreateFormFile("from_document", documentName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, document); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	fields := [][2]string{
+		{"name", r.Name},
+		{"default_title_voice_id", r.DefaultTitleVoiceID},
+		{"default_paragraph_voice_id", r.DefaultParagraphVoiceID},
+		{"default_model_id", r.DefaultModelID},
+		{"from_url", r.FromURL},
+		{"quality_preset", r.QualityPreset},
+		{"title", r.Title},
+		{"author", r.Author},
+		{"language", r.Language},
+	}
+	if r.AutoConvert != nil {
+		fields = append(fields, [2]string{"auto_convert", fmt.Sprintf("%t", *r.AutoConvert)})
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+// SetBlockVoice assigns a voice to all the text of the block with the given ID.
+//
+// It returns false if no block with that ID exists in the content.
+func (c *ChapterContent) SetBlockVoice(blockID, voiceID string) bool {
+	for i := range c.Blocks {
+		if c.Blocks[i].BlockID != blockID {
+			continue
+		}
+		for j := range c.Blocks[i].Nodes {
+			if c.Blocks[i].Nodes[j].Type == "tts_node" {
+				c.Blocks[i].Nodes[j].VoiceID = voiceID
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Types of pronunciation rules.
+const (
+	PronunciationRuleAlias   = "alias"
+	PronunciationRulePhoneme = "phoneme"
+)
+
+// Phonetic alphabets supported by phoneme pronunciation rules.
+const (
+	PhonemeAlphabetIPA = "ipa"
+	PhonemeAlphabetCMU = "cmu-arpabet"
+)
+
+// PronunciationRule represents a rule of a pronunciation dictionary, replacing every occurrence of StringToReplace
+// either with another spelling (Alias) or with an explicit pronunciation (Phoneme) in the given Alphabet.
+type PronunciationRule struct {
+	StringToReplace string `json:"string_to_replace"`
+	Type            string `json:"type"`
+	Alias           string `json:"alias,omitempty"`
+	Phoneme         string `json:"phoneme,omitempty"`
+	Alphabet        string `json:"alphabet,omitempty"`
+}
+
+// PronunciationDictionaryLocator identifies a version of a pronunciation dictionary to apply to a TextToSpeechRequest.
+type PronunciationDictionaryLocator struct {
+	PronunciationDictionaryID string `json:"pronunciation_dictionary_id"`
+	VersionID                 string `json:"version_id,omitempty"` // The latest version is used if empty
+}
+
+// PronunciationDictionary represents the metadata of a pronunciation dictionary.
+type PronunciationDictionary struct {
+	ID                    string `json:"id"`
+	LatestVersionID       string `json:"latest_version_id"`
+	LatestVersionRulesNum int    `json:"latest_version_rules_num"`
+	Name                  string `json:"name"`
+	Description           string `json:"description"`
+	CreatedBy             string `json:"created_by"`
+	CreationTimeUnix      int    `json:"creation_time_unix"`
+	ArchivedTimeUnix      int    `json:"archived_time_unix,omitempty"`
+}
+
+// Locator returns a locator for the latest version of the dictionary.
+func (d PronunciationDictionary) Locator() PronunciationDictionaryLocator {
+	return PronunciationDictionaryLocator{PronunciationDictionaryID: d.ID, VersionID: d.LatestVersionID}
+}
+
+// PronunciationDictionaryVersion represents the version of a pronunciation dictionary created by adding
+// the dictionary or by changing its rules.
+type PronunciationDictionaryVersion struct {
+	ID               string `json:"id"`
+	Name             string `json:"name,omitempty"`
+	VersionID        string `json:"version_id"`
+	VersionRulesNum  int    `json:"version_rules_num,omitempty"`
+	CreatedBy        string `json:"created_by,omitempty"`
+	CreationTimeUnix int    `json:"creation_time_unix,omitempty"`
+}
+
+// Locator returns a locator for this version of the dictionary.
+func (v PronunciationDictionaryVersion) Locator() PronunciationDictionaryLocator {
+	return PronunciationDictionaryLocator{PronunciationDictionaryID: v.ID, VersionID: v.VersionID}
+}
+
+type GetPronunciationDictionariesResponse struct {
+	PronunciationDictionaries []PronunciationDictionary `json:"pronunciation_dictionaries"`
+	HasMore                   bool                      `json:"has_more"`
+	NextCursor                string                    `json:"next_cursor"`
+}
+
+// UpdatePronunciationDictionaryRequest represents the request parameters for updating the metadata of a
+// pronunciation dictionary. Empty fields are left unchanged.
+type UpdatePronunciationDictionaryRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Archived    *bool  `json:"archived,omitempty"` // Archived dictionaries are hidden from GetPronunciationDictionaries
+}
+
+type getPronunciationDictionaryVersionsResponse struct {
+	Versions []PronunciationDictionaryVersion `json:"versions"`
+}
+
+// AddPronunciationDictionaryRequest represents the request parameters for adding a pronunciation dictionary from
+// a PLS file, such as one created with BuildPLS.
+type AddPronunciationDictionaryRequest struct {
+	Name            string
+	Description     string
+	File            io.Reader // PLS content, handled separately in multipart
+	FileName        string    // Original filename for multipart
+	WorkspaceAccess string    // "admin", "editor" or "viewer"
+}
+
+// AddPronunciationDictionaryFromRulesRequest represents the request parameters for adding a pronunciation
+// dictionary from a list of rules.
+type AddPronunciationDictionaryFromRulesRequest struct {
+	Name            string              `json:"name"`
+	Description     string              `json:"description,omitempty"`
+	Rules           []PronunciationRule `json:"rules"`
+	WorkspaceAccess string              `json:"workspace_access,omitempty"`
+}
+
+type addPronunciationRulesRequest struct {
+	Rules []PronunciationRule `json:"rules"`
+}
+
+type removePronunciationRulesRequest struct {
+	RuleStrings []string `json:"rule_strings"`
+}
+
+// buildRequestBody creates the multipart form request body for adding a pronunciation dictionary
+func (r *AddPronunciationDictionaryRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build pronunciation dictionary request body: %w", err)
+	}
+
+	if err := w.WriteField("name", r.Name); err != nil {
+		return buildFailed(err)
+	}
+	if r.Description != "" {
+		if err := w.WriteField("description", r.Description); err != nil {
+			return buildFailed(err)
+		}
+	}
+	if r.WorkspaceAccess != "" {
+		if err := w.WriteField("workspace_access", r.WorkspaceAccess); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.File != nil {
+		fw, err := w.CreateFormFile("file", r.FileName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, r.File); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+// DialogueInput represents a line of a dialogue and the voice it is spoken with.
+type DialogueInput struct {
+	Text    string `json:"text"`
+	VoiceID string `json:"voice_id"`
+}
+
+// DialogueSettings represents the settings applied to all the voices of a dialogue.
+type DialogueSettings struct {
+	Stability *float32 `json:"stability,omitempty"`
+}
+
+// TextToDialogueRequest represents the request parameters for rendering several lines spoken by different
+// voices as a single conversation.
+type TextToDialogueRequest struct {
+	Inputs                          []DialogueInput                  `json:"inputs"`
+	ModelID                         string                           `json:"model_id,omitempty"`
+	Settings                        *DialogueSettings                `json:"settings,omitempty"`
+	PronunciationDictionaryLocators []PronunciationDictionaryLocator `json:"pronunciation_dictionary_locators,omitempty"`
+	Seed                            *int                             `json:"seed,omitempty"`
+}
+
+// CharacterAlignment represents the timing of each character of a text in generated audio.
+type CharacterAlignment struct {
+	Characters                 []string  `json:"characters"`
+	CharacterStartTimesSeconds []float64 `json:"character_start_times_seconds"`
+	CharacterEndTimesSeconds   []float64 `json:"character_end_times_seconds"`
+}
+
+// DialogueVoiceSegment represents the part of the generated audio spoken for one of the dialogue inputs.
+type DialogueVoiceSegment struct {
+	VoiceID             string  `json:"voice_id"`
+	StartTimeSeconds    float64 `json:"start_time_seconds"`
+	EndTimeSeconds      float64 `json:"end_time_seconds"`
+	CharacterStartIndex int     `json:"character_start_index"`
+	CharacterEndIndex   int     `json:"character_end_index"`
+	DialogueInputIndex  int     `json:"dialogue_input_index"`
+}
+
+// TextToDialogueWithTimestampsResponse represents the response from text-to-dialogue with timestamps.
+type TextToDialogueWithTimestampsResponse struct {
+	AudioBase64         string                 `json:"audio_base64"`
+	Alignment           *CharacterAlignment    `json:"alignment,omitempty"`
+	NormalizedAlignment *CharacterAlignment    `json:"normalized_alignment,omitempty"`
+	VoiceSegments       []DialogueVoiceSegment `json:"voice_segments"`
+}
+
+// Audio decodes and returns the generated audio.
+func (r TextToDialogueWithTimestampsResponse) Audio() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(r.AudioBase64)
+}
+
+// VoiceDesignRequest represents the request parameters for generating voice previews from a description.
+type VoiceDesignRequest struct {
+	VoiceDescription string   `json:"voice_description"`            // Between 20 and 1000 characters, e.g. "A calm, elderly British narrator"
+	Text             string   `json:"text,omitempty"`               // Between 100 and 1000 characters. Required unless AutoGenerateText is set.
+	AutoGenerateText bool     `json:"auto_generate_text,omitempty"` // Generate a sample text suited to the description.
+	ModelID          string   `json:"model_id,omitempty"`
+	Loudness         *float64 `json:"loudness,omitempty"`       // Between -1 and 1. 0 corresponds to roughly -24 LUFS.
+	GuidanceScale    *float64 `json:"guidance_scale,omitempty"` // Higher values follow the description more closely.
+	Seed             *int     `json:"seed,omitempty"`
+}
+
+// VoicePreview represents a voice generated by DesignVoice, which can be added to the voices of the user with
+// CreateVoiceFromPreview.
+type VoicePreview struct {
+	GeneratedVoiceID string  `json:"generated_voice_id"`
+	AudioBase64      string  `json:"audio_base_64"`
+	MediaType        string  `json:"media_type"`
+	DurationSecs     float64 `json:"duration_secs"`
+	Language         string  `json:"language"`
+}
+
+// Audio decodes the sample text spoken by the previewed voice.
+func (p VoicePreview) Audio() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(p.AudioBase64)
+}
+
+// VoiceDesignResponse represents the response of DesignVoice.
+type VoiceDesignResponse struct {
+	Previews []VoicePreview `json:"previews"`
+	Text     string         `json:"text"` // The sample text spoken by the previews
+}
+
+// CreateVoiceFromPreviewRequest represents the request parameters for creating a voice from a VoicePreview.
+type CreateVoiceFromPreviewRequest struct {
+	VoiceName                 string            `json:"voice_name"`
+	VoiceDescription          string            `json:"voice_description"`
+	GeneratedVoiceID          string            `json:"generated_voice_id"`
+	Labels                    map[string]string `json:"labels,omitempty"`
+	PlayedNotSelectedVoiceIDs []string          `json:"played_not_selected_voice_ids,omitempty"` // The other previews that were listened to, to improve future generations.
+}
+
+// Sort orders of GetSharedVoices.
+const (
+	SharedVoicesSortTrending       = "trending"
+	SharedVoicesSortLatest         = "created_date"
+	SharedVoicesSortMostUsers      = "cloned_by_count"
+	SharedVoicesSortMostCharacters = "usage_character_count_1y"
+)
+
+// SharedVoicesFilter represents the search criteria of GetSharedVoices. Empty fields match all voices.
+type SharedVoicesFilter struct {
+	Search       string
+	Category     string // e.g. "professional", "high_quality" or "famous"
+	Gender       string // e.g. "male", "female" or "neutral"
+	Age          string // e.g. "young", "middle_aged" or "old"
+	Accent       string // e.g. "british"
+	Language     string // ISO 639-1 code, e.g. "en"
+	Locale       string // e.g. "en-GB"
+	UseCases     []string
+	Descriptives []string // e.g. "calm" or "deep"
+	Featured     bool
+	Sort         string // One of the SharedVoicesSort constants
+}
+
+func (f *SharedVoicesFilter) queries() []QueryFunc {
+	var queries []QueryFunc
+	add := func(key, value string) {
+		queries = append(queries, func(q *url.Values) { q.Add(key, value) })
+	}
+	for key, value := range map[string]string{
+		"search":   f.Search,
+		"category": f.Category,
+		"gender":   f.Gender,
+		"age":      f.Age,
+		"accent":   f.Accent,
+		"language": f.Language,
+		"locale":   f.Locale,
+		"sort":     f.Sort,
+	} {
+		if value != "" {
+			add(key, value)
+		}
+	}
+	for _, useCase := range f.UseCases {
+		add("use_cases", useCase)
+	}
+	for _, descriptive := range f.Descriptives {
+		add("descriptives", descriptive)
+	}
+	if f.Featured {
+		add("featured", "true")
+	}
+	return queries
+}
+
+// SharedVoice represents a voice of the voice library, as found by GetSharedVoices. Its sharing details, such as
+// its owner, name and popularity, are held in the embedded VoiceSharing.
+type SharedVoice struct {
+	VoiceSharing
+	VoiceID               string `json:"voice_id"`
+	Category              string `json:"category"`
+	Gender                string `json:"gender"`
+	Age                   string `json:"age"`
+	Accent                string `json:"accent"`
+	Descriptive           string `json:"descriptive"`
+	UseCase               string `json:"use_case"`
+	Language              string `json:"language"`
+	Locale                string `json:"locale"`
+	PreviewURL            string `json:"preview_url"`
+	Featured              bool   `json:"featured"`
+	UsageCharacterCount1y int64  `json:"usage_character_count_1y"`
+	UsageCharacterCount7d int64  `json:"usage_character_count_7d"`
+}
+
+type GetSharedVoicesResponse struct {
+	Voices  []SharedVoice `json:"voices"`
+	HasMore bool          `json:"has_more"`
+}
+
+type addSharedVoiceRequest struct {
+	NewName string `json:"new_name"`
+}
+
+// Values of FineTuning.FineTuningState.
+const (
+	FineTuningStateNotStarted = "not_started"
+	FineTuningStateQueued     = "queued"
+	FineTuningStateFineTuning = "fine_tuning"
+	FineTuningStateFineTuned  = "fine_tuned"
+	FineTuningStateFailed     = "failed"
+	FineTuningStateDelayed    = "delayed"
+)
+
+// Statuses of SpeakerSeparation.
+const (
+	SpeakerSeparationNotStarted = "not_started"
+	SpeakerSeparationPending    = "pending"
+	SpeakerSeparationCompleted  = "completed"
+	SpeakerSeparationFailed     = "failed"
+)
+
+// CreatePVCVoiceRequest represents the request parameters for creating a professional voice clone.
+type CreatePVCVoiceRequest struct {
+	Name        string            `json:"name"`
+	Language    string            `json:"language"` // ISO 639-1 code of the language spoken in the samples
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// AddPVCSamplesRequest represents the request parameters for uploading samples to a professional voice clone.
+type AddPVCSamplesRequest struct {
+	FilePaths             []string
+	Files                 []SampleFile // Samples read from memory or streams, sent after the ones of FilePaths
+	RemoveBackgroundNoise bool
+}
+
+func (r *AddPVCSamplesRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build request body: %w", err)
+	}
+
+	for _, path := range r.FilePaths {
+		if err := writeFormFile(w, "files", path); err != nil {
+			return buildFailed(err)
+		}
+	}
+	for _, file := range r.Files {
+		if err := writeSampleFile(w, "files", file); err != nil {
+			return buildFailed(err)
+		}
+	}
+	if r.RemoveBackgroundNoise {
+		if err := w.WriteField("remove_background_noise", "true"); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return buildFailed(err)
+	}
+	return &b, w.FormDataContentType(), nil
+}
+
+// writeFormFile copies the file at path to a new form file of w, closing it before returning.
+func writeFormFile(w *multipart.Writer, fieldName, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fw, err := w.CreateFormFile(fieldName, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, f)
+	return err
+}
+
+// PVCSample represents a sample uploaded to a professional voice clone.
+type PVCSample struct {
+	SampleID              string  `json:"sample_id"`
+	FileName              string  `json:"file_name"`
+	MimeType              string  `json:"mime_type"`
+	SizeBytes             int     `json:"size_bytes"`
+	DurationSecs          float64 `json:"duration_secs"`
+	RemoveBackgroundNoise bool    `json:"remove_background_noise"`
+	TrimStart             int     `json:"trim_start"`
+	TrimEnd               int     `json:"trim_end"`
+}
+
+// SpeakerSeparation represents the result of separating the speakers of a sample, started with
+// StartSpeakerSeparation. Speakers are keyed by their ID.
+type SpeakerSeparation struct {
+	VoiceID            string                      `json:"voice_id"`
+	SampleID           string                      `json:"sample_id"`
+	Status             string                      `json:"status"` // One of the SpeakerSeparation constants
+	Speakers           map[string]SeparatedSpeaker `json:"speakers"`
+	SelectedSpeakerIDs []string                    `json:"selected_speaker_ids"`
+}
+
+// SeparatedSpeaker represents a speaker found in a sample by speaker separation.
+type SeparatedSpeaker struct {
+	SpeakerID    string               `json:"speaker_id"`
+	DurationSecs float64              `json:"duration_secs"`
+	Utterances   []SeparatedUtterance `json:"utterances"`
+}
+
+// SeparatedUtterance represents an utterance of a SeparatedSpeaker, in seconds from the start of the sample.
+type SeparatedUtterance struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// UpdatePVCSampleRequest represents the request parameters for updating a sample of a professional voice clone.
+// Only the audio of the selected speakers, within the trimmed range, is used for training.
+type UpdatePVCSampleRequest struct {
+	SelectedSpeakerIDs    []string `json:"selected_speaker_ids,omitempty"`
+	TrimStartTime         *int     `json:"trim_start_time,omitempty"` // In milliseconds
+	TrimEndTime           *int     `json:"trim_end_time,omitempty"`   // In milliseconds
+	RemoveBackgroundNoise *bool    `json:"remove_background_noise,omitempty"`
+}
+
+// PVCVerificationRequest represents the request parameters for requesting the manual verification of a
+// professional voice clone, for voices that can't be verified with a captcha.
+type PVCVerificationRequest struct {
+	FilePaths []string // Documents proving the consent of the speaker
+	ExtraText string
+}
+
+func (r *PVCVerificationRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build request body: %w", err)
+	}
+
+	for _, path := range r.FilePaths {
+		if err := writeFormFile(w, "files", path); err != nil {
+			return buildFailed(err)
+		}
+	}
+	if r.ExtraText != "" {
+		if err := w.WriteField("extra_text", r.ExtraText); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return buildFailed(err)
+	}
+	return &b, w.FormDataContentType(), nil
+}
+
+type pvcCaptchaRequest struct {
+	Recording io.Reader
+	FileName  string
+}
+
+func (r *pvcCaptchaRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build request body: %w", err)
+	}
+
+	fw, err := w.CreateFormFile("recording", r.FileName)
+	if err != nil {
+		return buildFailed(err)
+	}
+	if _, err := io.Copy(fw, r.Recording); err != nil {
+		return buildFailed(err)
+	}
+
+	if err := w.Close(); err != nil {
+		return buildFailed(err)
+	}
+	return &b, w.FormDataContentType(), nil
+}
+
+type trainPVCVoiceRequest struct {
+	ModelID string `json:"model_id,omitempty"`
+}
+
+const (
+	AgentToolTypeWebhook = "webhook"
+	AgentToolTypeClient  = "client"
+	AgentToolTypeSystem  = "system"
+
+	KnowledgeBaseTypeFile = "file"
+	KnowledgeBaseTypeURL  = "url"
+	KnowledgeBaseTypeText = "text"
+)
+
+// AgentConfig represents the definition of a conversational AI agent, as sent to CreateAgent and UpdateAgent.
+//
+// Fields left to their zero value are omitted from requests, so that the server keeps its defaults (on creation)
+// or the current values (on update).
+type AgentConfig struct {
+	Name               string             `json:"name,omitempty"`
+	ConversationConfig ConversationConfig `json:"conversation_config"`
+	Tags               []string           `json:"tags,omitempty"`
+}
+
+// ConversationConfig represents the settings of the conversations held by an agent.
+type ConversationConfig struct {
+	Agent        *AgentSettings             `json:"agent,omitempty"`
+	TTS          *AgentTTSConfig            `json:"tts,omitempty"`
+	ASR          *AgentASRConfig            `json:"asr,omitempty"`
+	Turn         *AgentTurnConfig           `json:"turn,omitempty"`
+	Conversation *AgentConversationSettings `json:"conversation,omitempty"`
+}
+
+// AgentSettings represents the behaviour of an agent: how it opens conversations, in which language and with
+// which prompt.
+type AgentSettings struct {
+	FirstMessage string       `json:"first_message,omitempty"`
+	Language     string       `json:"language,omitempty"`
+	Prompt       *AgentPrompt `json:"prompt,omitempty"`
+}
+
+// AgentPrompt represents the system prompt of an agent and the LLM, tools and knowledge base it uses.
+type AgentPrompt struct {
+	Prompt        string                 `json:"prompt,omitempty"`
+	LLM           string                 `json:"llm,omitempty"`
+	Temperature   *float32               `json:"temperature,omitempty"`
+	MaxTokens     *int                   `json:"max_tokens,omitempty"`
+	ToolIDs       []string               `json:"tool_ids,omitempty"`
+	Tools         []AgentTool            `json:"tools,omitempty"`
+	KnowledgeBase []KnowledgeBaseLocator `json:"knowledge_base,omitempty"`
+}
+
+// AgentTool represents a tool an agent can call during a conversation. Webhook tools are called by the
+// ElevenLabs servers through APISchema, client tools are forwarded to the conversation client with Parameters,
+// and system tools are built into the platform.
+type AgentTool struct {
+	Type                string                `json:"type"`
+	Name                string                `json:"name"`
+	Description         string                `json:"description"`
+	Parameters          *JSONSchema           `json:"parameters,omitempty"`
+	ExpectsResponse     bool                  `json:"expects_response,omitempty"`
+	ResponseTimeoutSecs int                   `json:"response_timeout_secs,omitempty"`
+	APISchema           *WebhookToolAPISchema `json:"api_schema,omitempty"`
+}
+
+// WebhookToolAPISchema represents the HTTP endpoint called for a webhook tool.
+type WebhookToolAPISchema struct {
+	URL               string            `json:"url"`
+	Method            string            `json:"method,omitempty"`
+	RequestHeaders    map[string]string `json:"request_headers,omitempty"`
+	RequestBodySchema *JSONSchema       `json:"request_body_schema,omitempty"`
+}
+
+// JSONSchema represents the subset of JSON Schema used to describe the parameters of agent tools.
+type JSONSchema struct {
+	Type            string                `json:"type"`
+	Description     string                `json:"description,omitempty"`
+	Properties      map[string]JSONSchema `json:"properties,omitempty"`
+	Required        []string              `json:"required,omitempty"`
+	Items           *JSONSchema           `json:"items,omitempty"`
+	Enum            []interface{}         `json:"enum,omitempty"`            // Strings, numbers or booleans
+	ContentEncoding string                `json:"contentEncoding,omitempty"` // "base64" for byte slices
+}
+
+// KnowledgeBaseLocator references a knowledge base document made available to an agent.
+type KnowledgeBaseLocator struct {
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	ID        string `json:"id"`
+	UsageMode string `json:"usage_mode,omitempty"`
+}
+
+// AgentTTSConfig represents the voice settings of an agent.
+type AgentTTSConfig struct {
+	ModelID                  string   `json:"model_id,omitempty"`
+	VoiceID                  string   `json:"voice_id,omitempty"`
+	AgentOutputAudioFormat   string   `json:"agent_output_audio_format,omitempty"`
+	OptimizeStreamingLatency *int     `json:"optimize_streaming_latency,omitempty"`
+	Stability                *float32 `json:"stability,omitempty"`
+	SimilarityBoost          *float32 `json:"similarity_boost,omitempty"`
+	Speed                    *float32 `json:"speed,omitempty"`
+}
+
+// AgentASRConfig represents the speech recognition settings of an agent.
+type AgentASRConfig struct {
+	Quality              string   `json:"quality,omitempty"`
+	Provider             string   `json:"provider,omitempty"`
+	UserInputAudioFormat string   `json:"user_input_audio_format,omitempty"`
+	Keywords             []string `json:"keywords,omitempty"`
+}
+
+// AgentTurnConfig represents the turn-taking settings of an agent.
+type AgentTurnConfig struct {
+	TurnTimeout *float32 `json:"turn_timeout,omitempty"`
+	Mode        string   `json:"mode,omitempty"`
+}
+
+// AgentConversationSettings represents the limits of the conversations held by an agent and the events sent to
+// conversation clients.
+type AgentConversationSettings struct {
+	MaxDurationSeconds int      `json:"max_duration_seconds,omitempty"`
+	ClientEvents       []string `json:"client_events,omitempty"`
+}
+
+// Agent represents a conversational AI agent, as returned by GetAgent.
+type Agent struct {
+	AgentID string `json:"agent_id"`
+	AgentConfig
+	Metadata AgentMetadata `json:"metadata"`
+}
+
+type AgentMetadata struct {
+	CreatedAtUnixSecs int64 `json:"created_at_unix_secs"`
+}
+
+// AgentSummary represents an agent as listed by GetAgents.
+type AgentSummary struct {
+	AgentID           string   `json:"agent_id"`
+	Name              string   `json:"name"`
+	Tags              []string `json:"tags"`
+	CreatedAtUnixSecs int64    `json:"created_at_unix_secs"`
+}
+
+type GetAgentsResponse struct {
+	Agents     []AgentSummary `json:"agents"`
+	HasMore    bool           `json:"has_more"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+type duplicateAgentRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+type agentIDResponse struct {
+	AgentID string `json:"agent_id"`
+}
+
+// ConversationSessionConfig represents the settings of a conversation with an agent held over WebSocket.
+//
+// ConfigOverride replaces parts of the agent configuration for this conversation only, e.g. the prompt, first
+// message, language or voice. The agent must allow the overridden fields in its security settings.
+type ConversationSessionConfig struct {
+	AgentID          string
+	ConfigOverride   *ConversationConfig
+	DynamicVariables map[string]interface{}
+}
+
+// ConversationMetadata represents the details of a conversation sent by the server once it has started.
+type ConversationMetadata struct {
+	ConversationID         string `json:"conversation_id"`
+	AgentOutputAudioFormat string `json:"agent_output_audio_format"`
+	UserInputAudioFormat   string `json:"user_input_audio_format"`
+}
+
+// AgentAudioEvent represents a chunk of the audio spoken by the agent, encoded in the agent output audio format.
+type AgentAudioEvent struct {
+	EventID int
+	Audio   []byte
+}
+
+// UserTranscriptEvent represents the transcript of what the user said during a turn.
+type UserTranscriptEvent struct {
+	UserTranscript string `json:"user_transcript"`
+}
+
+// AgentResponseEvent represents the text of a response of the agent.
+type AgentResponseEvent struct {
+	AgentResponse string `json:"agent_response"`
+}
+
+// AgentResponseCorrectionEvent represents a response of the agent that was cut short by an interruption, with
+// the text that was actually spoken.
+type AgentResponseCorrectionEvent struct {
+	OriginalAgentResponse  string `json:"original_agent_response"`
+	CorrectedAgentResponse string `json:"corrected_agent_response"`
+}
+
+// InterruptionEvent represents the user interrupting the agent. Audio events up to EventID are discarded and
+// any audio of the agent still being played should be stopped.
+type InterruptionEvent struct {
+	EventID int `json:"event_id"`
+}
+
+// ClientToolCallEvent represents a request of the agent to run a client tool. Parameters holds the JSON object
+// of arguments described by the tool's parameters schema.
+type ClientToolCallEvent struct {
+	ToolName   string          `json:"tool_name"`
+	ToolCallID string          `json:"tool_call_id"`
+	Parameters json.RawMessage `json:"parameters"`
+}
+
+type signedURLResponse struct {
+	SignedURL string `json:"signed_url"`
+}
+
+type conversationTokenResponse struct {
+	Token string `json:"token"`
+}
+
+const (
+	ConversationStatusInitiated  = "initiated"
+	ConversationStatusInProgress = "in-progress"
+	ConversationStatusProcessing = "processing"
+	ConversationStatusDone       = "done"
+	ConversationStatusFailed     = "failed"
+
+	EvaluationSuccess = "success"
+	EvaluationFailure = "failure"
+	EvaluationUnknown = "unknown"
+
+	ConversationRoleUser  = "user"
+	ConversationRoleAgent = "agent"
+
+	FeedbackLike    = "like"
+	FeedbackDislike = "dislike"
+)
+
+// ConversationFilter represents the criteria used to select the conversations listed by IterateConversations.
+// Zero values don't filter.
+type ConversationFilter struct {
+	AgentID         string
+	CallStartAfter  time.Time
+	CallStartBefore time.Time
+	CallSuccessful  string // EvaluationSuccess, EvaluationFailure or EvaluationUnknown
+	Status          string // One of the ConversationStatus constants. Applied locally, as the API can't filter on it.
+	PageSize        int
+}
+
+func (f *ConversationFilter) queries() []QueryFunc {
+	var queries []QueryFunc
+	add := func(key, value string) {
+		queries = append(queries, func(q *url.Values) { q.Add(key, value) })
+	}
+	if f.AgentID != "" {
+		add("agent_id", f.AgentID)
+	}
+	if !f.CallStartAfter.IsZero() {
+		add("call_start_after_unix", strconv.FormatInt(f.CallStartAfter.Unix(), 10))
+	}
+	if !f.CallStartBefore.IsZero() {
+		add("call_start_before_unix", strconv.FormatInt(f.CallStartBefore.Unix(), 10))
+	}
+	if f.CallSuccessful != "" {
+		add("call_successful", f.CallSuccessful)
+	}
+	if f.PageSize > 0 {
+		queries = append(queries, PageSize(f.PageSize))
+	}
+	return queries
+}
+
+// ConversationSummary represents a conversation as listed by GetConversations.
+type ConversationSummary struct {
+	AgentID           string `json:"agent_id"`
+	AgentName         string `json:"agent_name"`
+	ConversationID    string `json:"conversation_id"`
+	StartTimeUnixSecs int64  `json:"start_time_unix_secs"`
+	CallDurationSecs  int    `json:"call_duration_secs"`
+	MessageCount      int    `json:"message_count"`
+	Status            string `json:"status"`
+	CallSuccessful    string `json:"call_successful"`
+}
+
+type GetConversationsResponse struct {
+	Conversations []ConversationSummary `json:"conversations"`
+	HasMore       bool                  `json:"has_more"`
+	NextCursor    string                `json:"next_cursor"`
+}
+
+// ConversationDetails represents a conversation with its transcript, metadata and analysis, as returned by
+// GetConversation.
+type ConversationDetails struct {
+	AgentID          string                   `json:"agent_id"`
+	ConversationID   string                   `json:"conversation_id"`
+	Status           string                   `json:"status"`
+	Transcript       []ConversationTurn       `json:"transcript"`
+	Metadata         ConversationCallMetadata `json:"metadata"`
+	Analysis         *ConversationAnalysis    `json:"analysis,omitempty"` // Only set once the conversation is done
+	HasAudio         bool                     `json:"has_audio"`
+	HasUserAudio     bool                     `json:"has_user_audio"`
+	HasResponseAudio bool                     `json:"has_response_audio"`
+}
+
+// ConversationTurn represents a message of the user or the agent in a conversation transcript, along with the
+// tools the agent called.
+type ConversationTurn struct {
+	Role           string                   `json:"role"`
+	Message        string                   `json:"message"`
+	TimeInCallSecs float64                  `json:"time_in_call_secs"`
+	ToolCalls      []ConversationToolCall   `json:"tool_calls,omitempty"`
+	ToolResults    []ConversationToolResult `json:"tool_results,omitempty"`
+}
+
+type ConversationToolCall struct {
+	RequestID    string `json:"request_id"`
+	ToolName     string `json:"tool_name"`
+	ParamsAsJSON string `json:"params_as_json"`
+}
+
+type ConversationToolResult struct {
+	RequestID   string `json:"request_id"`
+	ToolName    string `json:"tool_name"`
+	ResultValue string `json:"result_value"`
+	IsError     bool   `json:"is_error"`
+}
+
+// ConversationCallMetadata represents the timing and cost of a conversation.
+type ConversationCallMetadata struct {
+	StartTimeUnixSecs int64  `json:"start_time_unix_secs"`
+	CallDurationSecs  int    `json:"call_duration_secs"`
+	Cost              int    `json:"cost"`
+	TerminationReason string `json:"termination_reason"`
+}
+
+// ConversationAnalysis represents the evaluation of a conversation against the success criteria of the agent and
+// the data collected from it.
+type ConversationAnalysis struct {
+	CallSuccessful            string                               `json:"call_successful"`
+	TranscriptSummary         string                               `json:"transcript_summary"`
+	EvaluationCriteriaResults map[string]EvaluationCriterionResult `json:"evaluation_criteria_results"`
+	DataCollectionResults     map[string]DataCollectionResult      `json:"data_collection_results"`
+}
+
+type EvaluationCriterionResult struct {
+	CriteriaID string `json:"criteria_id"`
+	Result     string `json:"result"`
+	Rationale  string `json:"rationale"`
+}
+
+type DataCollectionResult struct {
+	DataCollectionID string      `json:"data_collection_id"`
+	Value            interface{} `json:"value"`
+	Rationale        string      `json:"rationale"`
+}
+
+// Utterances returns the spoken turns of the transcript as utterances whose SpeakerID is the role of the speaker
+// (ConversationRoleUser or ConversationRoleAgent). Each utterance ends when the next one starts, or at the end
+// of the call for the last one. Turns without a message, such as tool calls, are skipped.
+func (d ConversationDetails) Utterances() []SpeakerUtterance {
+	var utterances []SpeakerUtterance
+	for _, turn := range d.Transcript {
+		if turn.Message == "" {
+			continue
+		}
+		if n := len(utterances); n > 0 {
+			utterances[n-1].End = turn.TimeInCallSecs
+		}
+		utterances = append(utterances, SpeakerUtterance{SpeakerID: turn.Role, Text: turn.Message, Start: turn.TimeInCallSecs})
+	}
+	if n := len(utterances); n > 0 {
+		utterances[n-1].End = math.Max(utterances[n-1].Start, float64(d.Metadata.CallDurationSecs))
+	}
+	return utterances
+}
+
+type conversationFeedbackRequest struct {
+	Feedback string `json:"feedback"`
+}
+
+const (
+	RAGIndexStatusCreated       = "created"
+	RAGIndexStatusProcessing    = "processing"
+	RAGIndexStatusFailed        = "failed"
+	RAGIndexStatusSucceeded     = "succeeded"
+	RAGIndexStatusLimitExceeded = "rag_limit_exceeded"
+
+	RAGEmbeddingModelE5Mistral      = "e5_mistral_7b_instruct"
+	RAGEmbeddingModelMultilingualE5 = "multilingual_e5_large_instruct"
+)
+
+// AddKnowledgeBaseFileRequest represents the request parameters for uploading a document, such as a PDF, text,
+// HTML or Markdown file, to the knowledge base.
+type AddKnowledgeBaseFileRequest struct {
+	Name     string    // Defaults to the file name when empty
+	File     io.Reader // File content, handled separately in multipart
+	FileName string    // Original filename for multipart
+}
+
+func (r *AddKnowledgeBaseFileRequest) buildRequestBody() (*bytes.Buffer, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	buildFailed := func(err error) (*bytes.Buffer, string, error) {
+		return nil, "", fmt.Errorf("failed to build knowledge base document request body: %w", err)
+	}
+
+	if r.Name != "" {
+		if err := w.WriteField("name", r.Name); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	if r.File != nil {
+		fw, err := w.CreateFormFile("file", r.FileName)
+		if err != nil {
+			return buildFailed(err)
+		}
+		if _, err = io.Copy(fw, r.File); err != nil {
+			return buildFailed(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		return buildFailed(err)
+	}
+
+	return &b, w.FormDataContentType(), nil
+}
+
+type addKnowledgeBaseURLRequest struct {
+	URL  string `json:"url"`
+	Name string `json:"name,omitempty"`
+}
+
+type addKnowledgeBaseTextRequest struct {
+	Text string `json:"text"`
+	Name string `json:"name,omitempty"`
+}
+
+// KnowledgeBaseDocument represents a document of the knowledge base. URL is only set for documents created from
+// a URL.
+type KnowledgeBaseDocument struct {
+	ID       string                        `json:"id"`
+	Name     string                        `json:"name"`
+	Type     string                        `json:"type"` // KnowledgeBaseTypeFile, KnowledgeBaseTypeURL or KnowledgeBaseTypeText
+	URL      string                        `json:"url,omitempty"`
+	Metadata KnowledgeBaseDocumentMetadata `json:"metadata"`
+}
+
+type KnowledgeBaseDocumentMetadata struct {
+	CreatedAtUnixSecs     int64 `json:"created_at_unix_secs"`
+	LastUpdatedAtUnixSecs int64 `json:"last_updated_at_unix_secs"`
+	SizeBytes             int   `json:"size_bytes"`
+}
+
+// Locator returns the KnowledgeBaseLocator referencing the document in an AgentPrompt.
+func (d KnowledgeBaseDocument) Locator() KnowledgeBaseLocator {
+	return KnowledgeBaseLocator{Type: d.Type, Name: d.Name, ID: d.ID}
+}
+
+type GetKnowledgeBaseDocumentsResponse struct {
+	Documents  []KnowledgeBaseDocument `json:"documents"`
+	HasMore    bool                    `json:"has_more"`
+	NextCursor string                  `json:"next_cursor"`
+}
+
+// RAGIndex represents the retrieval-augmented generation index of a knowledge base document for an embedding
+// model.
+type RAGIndex struct {
+	ID                 string  `json:"id"`
+	Model              string  `json:"model"`
+	Status             string  `json:"status"`
+	ProgressPercentage float64 `json:"progress_percentage"`
+}
+
+type computeRAGIndexRequest struct {
+	Model string `json:"model"`
+}
+
+const (
+	PostCallTranscriptionWebhook = "post_call_transcription"
+	PostCallAudioWebhook         = "post_call_audio"
+	CallInitiationFailureWebhook = "call_initiation_failure"
+)
+
+// PostCallTranscriptionEvent represents the webhook event sent once a conversation has been analyzed.
+type PostCallTranscriptionEvent struct {
+	EventTimestamp int64               `json:"event_timestamp"`
+	Data           ConversationDetails `json:"data"`
+}
+
+// PostCallAudioEvent represents the webhook event sent with the recording of a conversation. The recording itself
+// is passed separately to PostCallWebhookConfig.OnAudio.
+type PostCallAudioEvent struct {
+	EventTimestamp int64             `json:"event_timestamp"`
+	Data           PostCallAudioData `json:"data"`
+}
+
+type PostCallAudioData struct {
+	AgentID        string `json:"agent_id"`
+	ConversationID string `json:"conversation_id"`
+}
+
+// CallInitiationFailureEvent represents the webhook event sent when an outbound call couldn't be connected.
+// Metadata holds the provider-specific details of the failure, e.g. the Twilio status callback.
+type CallInitiationFailureEvent struct {
+	EventTimestamp int64                     `json:"event_timestamp"`
+	Data           CallInitiationFailureData `json:"data"`
+}
+
+type CallInitiationFailureData struct {
+	AgentID        string          `json:"agent_id"`
+	ConversationID string          `json:"conversation_id"`
+	FailureReason  string          `json:"failure_reason"` // "busy", "no-answer" or "unknown"
+	Metadata       json.RawMessage `json:"metadata"`
+}
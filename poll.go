@@ -0,0 +1,42 @@
+package elevenlabs
+
+import (
+	"context"
+	"time"
+)
+
+// defaultPollInterval replaces the non-positive intervals given to pollWithBackoff, which would otherwise poll
+// without any delay.
+const defaultPollInterval = 1 * time.Second
+
+// pollWithBackoff calls check until it reports done, returns an error or ctx is done. The first call is made
+// immediately and the delay between subsequent calls starts at interval, or defaultPollInterval if it isn't
+// positive, and doubles up to maxInterval.
+func pollWithBackoff(ctx context.Context, interval, maxInterval time.Duration, check func() (bool, error)) error {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		timer.Reset(interval)
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
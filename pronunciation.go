@@ -0,0 +1,82 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+// AliasRule returns a PronunciationRule that replaces every occurrence of grapheme with alias, e.g. "UN"
+// with "United Nations".
+func AliasRule(grapheme, alias string) PronunciationRule {
+	return PronunciationRule{StringToReplace: grapheme, Type: PronunciationRuleAlias, Alias: alias}
+}
+
+// PhonemeRule returns a PronunciationRule that pronounces every occurrence of grapheme as phoneme, written in
+// the given alphabet (PhonemeAlphabetIPA or PhonemeAlphabetCMU).
+//
+// Phoneme rules are only honored by some models. Alias rules should be preferred when possible.
+func PhonemeRule(grapheme, phoneme, alphabet string) PronunciationRule {
+	return PronunciationRule{StringToReplace: grapheme, Type: PronunciationRulePhoneme, Phoneme: phoneme, Alphabet: alphabet}
+}
+
+// BuildPLS builds a W3C Pronunciation Lexicon Specification (PLS) 1.0 document from a list of rules. The
+// document can be uploaded with AddPronunciationDictionary.
+//
+// It takes a string argument that represents the language of the lexicon as a BCP 47 tag (e.g. "en-US") and the
+// rules to include. The alphabet of the lexicon is that of the first phoneme rule, or IPA if there are none;
+// phoneme rules written in a different alphabet carry their own alphabet attribute.
+//
+// It returns the PLS document or an error if a rule is incomplete.
+func BuildPLS(language string, rules ...PronunciationRule) ([]byte, error) {
+	alphabet := PhonemeAlphabetIPA
+	for _, r := range rules {
+		if r.Type == PronunciationRulePhoneme && r.Alphabet != "" {
+			alphabet = r.Alphabet
+			break
+		}
+	}
+
+	b := bytes.Buffer{}
+	b.WriteString(xml.Header)
+	b.WriteString(`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"`)
+	b.WriteString(` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`)
+	b.WriteString(` xsi:schemaLocation="http://www.w3.org/2005/01/pronunciation-lexicon http://www.w3.org/TR/2007/CR-pronunciation-lexicon-20071212/pls.xsd"`)
+	fmt.Fprintf(&b, ` alphabet="%s" xml:lang="%s">`+"\n", escapeXML(alphabet), escapeXML(language))
+
+	for i, r := range rules {
+		if r.StringToReplace == "" {
+			return nil, fmt.Errorf("pronunciation rule %d: string to replace is empty", i)
+		}
+		b.WriteString("  <lexeme>\n")
+		fmt.Fprintf(&b, "    <grapheme>%s</grapheme>\n", escapeXML(r.StringToReplace))
+		switch r.Type {
+		case PronunciationRuleAlias:
+			if r.Alias == "" {
+				return nil, fmt.Errorf("pronunciation rule %d (%q): alias is empty", i, r.StringToReplace)
+			}
+			fmt.Fprintf(&b, "    <alias>%s</alias>\n", escapeXML(r.Alias))
+		case PronunciationRulePhoneme:
+			if r.Phoneme == "" {
+				return nil, fmt.Errorf("pronunciation rule %d (%q): phoneme is empty", i, r.StringToReplace)
+			}
+			if r.Alphabet != "" && r.Alphabet != alphabet {
+				fmt.Fprintf(&b, "    <phoneme alphabet=\"%s\">%s</phoneme>\n", escapeXML(r.Alphabet), escapeXML(r.Phoneme))
+			} else {
+				fmt.Fprintf(&b, "    <phoneme>%s</phoneme>\n", escapeXML(r.Phoneme))
+			}
+		default:
+			return nil, fmt.Errorf("pronunciation rule %d (%q): unknown rule type %q", i, r.StringToReplace, r.Type)
+		}
+		b.WriteString("  </lexeme>\n")
+	}
+
+	b.WriteString("</lexicon>\n")
+	return b.Bytes(), nil
+}
+
+func escapeXML(s string) string {
+	b := bytes.Buffer{}
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,213 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestBuildPLS(t *testing.T) {
+	pls, err := elevenlabs.BuildPLS("en-US",
+		elevenlabs.AliasRule("UN", "United Nations"),
+		elevenlabs.PhonemeRule("tomato", "/tə'meɪtoʊ/", elevenlabs.PhonemeAlphabetIPA),
+		elevenlabs.PhonemeRule("Nginx", "EH1 N JH IH0 N EH1 K S", elevenlabs.PhonemeAlphabetCMU),
+		elevenlabs.AliasRule("R&D", "research and development"),
+	)
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+
+	var lexicon struct {
+		Alphabet string `xml:"alphabet,attr"`
+		Lang     string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		Lexemes  []struct {
+			Grapheme string `xml:"grapheme"`
+			Alias    string `xml:"alias"`
+			Phoneme  struct {
+				Alphabet string `xml:"alphabet,attr"`
+				Value    string `xml:",chardata"`
+			} `xml:"phoneme"`
+		} `xml:"lexeme"`
+	}
+	if err := xml.Unmarshal(pls, &lexicon); err != nil {
+		t.Fatalf("Expected valid XML, got error %q in:\n%s", err, pls)
+	}
+	if lexicon.Alphabet != elevenlabs.PhonemeAlphabetIPA || lexicon.Lang != "en-US" || len(lexicon.Lexemes) != 4 {
+		t.Fatalf("Unexpected lexicon: %+v", lexicon)
+	}
+	if l := lexicon.Lexemes[0]; l.Grapheme != "UN" || l.Alias != "United Nations" {
+		t.Errorf("Unexpected alias lexeme: %+v", l)
+	}
+	if l := lexicon.Lexemes[1]; l.Grapheme != "tomato" || l.Phoneme.Value != "/tə'meɪtoʊ/" || l.Phoneme.Alphabet != "" {
+		t.Errorf("Unexpected IPA lexeme: %+v", l)
+	}
+	if l := lexicon.Lexemes[2]; l.Phoneme.Alphabet != elevenlabs.PhonemeAlphabetCMU {
+		t.Errorf("Expected CMU lexeme to carry its own alphabet, got %+v", l)
+	}
+	if l := lexicon.Lexemes[3]; l.Grapheme != "R&D" {
+		t.Errorf("Expected escaped grapheme to round-trip, got %+v", l)
+	}
+}
+
+func TestBuildPLSInvalidRules(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule elevenlabs.PronunciationRule
+	}{
+		{name: "Empty grapheme", rule: elevenlabs.AliasRule("", "alias")},
+		{name: "Empty alias", rule: elevenlabs.AliasRule("UN", "")},
+		{name: "Empty phoneme", rule: elevenlabs.PhonemeRule("tomato", "", elevenlabs.PhonemeAlphabetIPA)},
+		{name: "Unknown type", rule: elevenlabs.PronunciationRule{StringToReplace: "UN", Type: "spelling"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := elevenlabs.BuildPLS("en-US", tc.rule); err == nil {
+				t.Errorf("Expected an error for rule %+v", tc.rule)
+			}
+		})
+	}
+}
+
+func TestPronunciationDictionaries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "POST /pronunciation-dictionaries/add-from-file":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse multipart form: %v", err)
+				return
+			}
+			f, _, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("Server: expected file: %v", err)
+				return
+			}
+			pls, _ := io.ReadAll(f)
+			if r.FormValue("name") != "Brands" || !bytes.Contains(pls, []byte("<grapheme>Hoshii</grapheme>")) {
+				t.Errorf("Server: unexpected dictionary upload %v %s", r.MultipartForm.Value, pls)
+			}
+			w.Write([]byte(`{"id": "d1", "name": "Brands", "version_id": "v1"}`))
+		case "POST /pronunciation-dictionaries/d1/add-rules":
+			var body map[string][]elevenlabs.PronunciationRule
+			json.NewDecoder(r.Body).Decode(&body)
+			if len(body["rules"]) != 1 || body["rules"][0].Alias != "Eleven Labs" {
+				t.Errorf("Server: unexpected rules %+v", body)
+			}
+			w.Write([]byte(`{"id": "d1", "version_id": "v2"}`))
+		case "POST /pronunciation-dictionaries/d1/remove-rules":
+			b, _ := io.ReadAll(r.Body)
+			if string(b) != `{"rule_strings":["Hoshii"]}` {
+				t.Errorf("Server: unexpected remove-rules body %s", b)
+			}
+			w.Write([]byte(`{"id": "d1", "version_id": "v3"}`))
+		case "GET /pronunciation-dictionaries/d1":
+			w.Write([]byte(`{"id": "d1", "name": "Brands", "latest_version_id": "v3", "latest_version_rules_num": 1}`))
+		case "GET /pronunciation-dictionaries":
+			w.Write([]byte(`{"pronunciation_dictionaries": [{"id": "d1", "latest_version_id": "v3"}], "has_more": false}`))
+		case "GET /pronunciation-dictionaries/d1/v3/download":
+			w.Write([]byte("<lexicon/>"))
+		case "GET /pronunciation-dictionaries/d1/versions":
+			w.Write([]byte(`{"versions": [{"version_id": "v3", "version_rules_num": 1}, {"version_id": "v2", "version_rules_num": 2}]}`))
+		case "PATCH /pronunciation-dictionaries/d1":
+			b, _ := io.ReadAll(r.Body)
+			switch string(b) {
+			case `{"name":"Products"}`:
+				w.Write([]byte(`{"id": "d1", "name": "Products", "latest_version_id": "v3"}`))
+			case `{"archived":true}`:
+				w.Write([]byte(`{"id": "d1", "name": "Products", "latest_version_id": "v3", "archived_time_unix": 1700000000}`))
+			default:
+				t.Errorf("Server: unexpected update body %s", b)
+			}
+		default:
+			t.Errorf("Server: unexpected request %q", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	pls, err := elevenlabs.BuildPLS("en-US", elevenlabs.AliasRule("Hoshii", "Ho-shee"))
+	if err != nil {
+		t.Fatalf("Expected no errors building PLS, got error: %q", err)
+	}
+	version, err := client.AddPronunciationDictionary(elevenlabs.AddPronunciationDictionaryRequest{
+		Name:     "Brands",
+		File:     bytes.NewReader(pls),
+		FileName: "brands.pls",
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors adding dictionary, got error: %q", err)
+	}
+	if loc := version.Locator(); loc.PronunciationDictionaryID != "d1" || loc.VersionID != "v1" {
+		t.Errorf("Unexpected locator: %+v", loc)
+	}
+
+	version, err = client.AddPronunciationRules("d1", []elevenlabs.PronunciationRule{elevenlabs.AliasRule("ElevenLabs", "Eleven Labs")})
+	if err != nil || version.VersionID != "v2" {
+		t.Errorf("Unexpected result adding rules: %+v, %v", version, err)
+	}
+	version, err = client.RemovePronunciationRules("d1", []string{"Hoshii"})
+	if err != nil || version.VersionID != "v3" {
+		t.Errorf("Unexpected result removing rules: %+v, %v", version, err)
+	}
+
+	dict, err := client.GetPronunciationDictionary("d1")
+	if err != nil {
+		t.Fatalf("Expected no errors getting dictionary, got error: %q", err)
+	}
+	if dict.Locator().VersionID != "v3" || dict.LatestVersionRulesNum != 1 {
+		t.Errorf("Unexpected dictionary: %+v", dict)
+	}
+
+	dictResp, nextPage, err := client.GetPronunciationDictionaries()
+	if err != nil || nextPage != nil || len(dictResp.PronunciationDictionaries) != 1 {
+		t.Errorf("Unexpected dictionaries list: %+v, %v", dictResp, err)
+	}
+
+	w := bytes.Buffer{}
+	if err := client.DownloadPronunciationDictionaryVersion(&w, "d1", "v3"); err != nil || w.String() != "<lexicon/>" {
+		t.Errorf("Unexpected download %q, %v", w.String(), err)
+	}
+
+	versions, err := client.GetPronunciationDictionaryVersions("d1")
+	if err != nil {
+		t.Fatalf("Expected no errors getting versions, got error: %q", err)
+	}
+	if len(versions) != 2 || versions[1].Locator() != (elevenlabs.PronunciationDictionaryLocator{PronunciationDictionaryID: "d1", VersionID: "v2"}) {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+
+	dict, err = client.UpdatePronunciationDictionary("d1", elevenlabs.UpdatePronunciationDictionaryRequest{Name: "Products"})
+	if err != nil || dict.Name != "Products" {
+		t.Errorf("Unexpected result updating dictionary: %+v, %v", dict, err)
+	}
+	dict, err = client.ArchivePronunciationDictionary("d1")
+	if err != nil || dict.ArchivedTimeUnix == 0 {
+		t.Errorf("Unexpected result archiving dictionary: %+v, %v", dict, err)
+	}
+}
+
+func TestTextToSpeechRequestPronunciationLocators(t *testing.T) {
+	ttsReq := elevenlabs.TextToSpeechRequest{
+		Text: "Welcome to Hoshii",
+		PronunciationDictionaryLocators: []elevenlabs.PronunciationDictionaryLocator{
+			{PronunciationDictionaryID: "d1", VersionID: "v3"},
+		},
+	}
+	b, err := json.Marshal(ttsReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `"pronunciation_dictionary_locators":[{"pronunciation_dictionary_id":"d1","version_id":"v3"}]`
+	if !strings.Contains(string(b), exp) {
+		t.Errorf("Expected %s to contain %s", b, exp)
+	}
+}
@@ -0,0 +1,263 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPVCPollInterval    = 5 * time.Second
+	defaultPVCMaxPollInterval = 1 * time.Minute
+)
+
+// Steps of a PVCWorkflow, in order.
+const (
+	PVCStepCreate       = "create"       // The voice doesn't exist yet.
+	PVCStepSamples      = "samples"      // Samples are uploaded and their speakers separated.
+	PVCStepVerification = "verification" // The consent of the speaker is being verified.
+	PVCStepTraining     = "training"     // The voice is verified and can be trained.
+	PVCStepDone         = "done"         // The voice is trained and ready for use.
+)
+
+// PVCState represents the progress of a PVCWorkflow. It holds no secrets and can be saved, e.g. encoded as JSON,
+// to resume the workflow in another process with ResumePVCWorkflow.
+type PVCState struct {
+	Step      string   `json:"step"` // One of the PVCStep constants
+	VoiceID   string   `json:"voice_id,omitempty"`
+	SampleIDs []string `json:"sample_ids,omitempty"`
+	// TrainingStarted is set once training has started, for the model ModelID, or the default model if empty.
+	TrainingStarted bool   `json:"training_started,omitempty"`
+	ModelID         string `json:"model_id,omitempty"`
+}
+
+// PVCWorkflow walks through the creation of a professional voice clone, one step at a time:
+//
+//  1. Create creates the voice.
+//  2. AddSamples uploads recordings of the speaker. SeparateSpeakers and SelectSpeakers isolate the speaker in
+//     samples where other people can be heard.
+//  3. Captcha and VerifyCaptcha, or RequestVerification, prove the consent of the speaker.
+//  4. Train starts training the voice for a model and WaitForTraining waits for it to complete.
+//
+// Each method checks that it is called at the right step and advances State when it succeeds, so that State
+// always records where to resume after an interruption. Methods called at the wrong step return an error
+// wrapping ErrPVCStep. The waiting methods can safely be called again after an interruption.
+type PVCWorkflow struct {
+	State PVCState
+
+	// PollInterval is the initial delay between status checks performed by SeparateSpeakers and WaitForTraining.
+	// The delay doubles after every check until it reaches MaxPollInterval.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	client *Client
+}
+
+// NewPVCWorkflow returns a PVCWorkflow for a new professional voice clone. No request is made.
+func (c *Client) NewPVCWorkflow() *PVCWorkflow {
+	return c.ResumePVCWorkflow(PVCState{Step: PVCStepCreate})
+}
+
+// ResumePVCWorkflow returns a PVCWorkflow continuing from a state saved from a previous workflow. No request is
+// made.
+func (c *Client) ResumePVCWorkflow(state PVCState) *PVCWorkflow {
+	return &PVCWorkflow{
+		State:           state,
+		PollInterval:    defaultPVCPollInterval,
+		MaxPollInterval: defaultPVCMaxPollInterval,
+		client:          c,
+	}
+}
+
+// checkStep returns an error wrapping ErrPVCStep unless the workflow is at one of steps.
+func (w *PVCWorkflow) checkStep(action string, steps ...string) error {
+	for _, step := range steps {
+		if w.State.Step == step {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: can't %s at step %q", ErrPVCStep, action, w.State.Step)
+}
+
+// Create creates the voice and moves the workflow to PVCStepSamples.
+func (w *PVCWorkflow) Create(voiceReq CreatePVCVoiceRequest, opts ...RequestOption) error {
+	if err := w.checkStep("create voice", PVCStepCreate); err != nil {
+		return err
+	}
+	voiceID, err := w.client.CreatePVCVoice(voiceReq, opts...)
+	if err != nil {
+		return err
+	}
+	w.State.VoiceID = voiceID
+	w.State.Step = PVCStepSamples
+	return nil
+}
+
+// AddSamples uploads samples to the voice. It can be called several times at PVCStepSamples.
+func (w *PVCWorkflow) AddSamples(samplesReq AddPVCSamplesRequest, opts ...RequestOption) ([]PVCSample, error) {
+	if err := w.checkStep("add samples", PVCStepSamples); err != nil {
+		return nil, err
+	}
+	samples, err := w.client.AddPVCSamples(w.State.VoiceID, samplesReq, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, sample := range samples {
+		w.State.SampleIDs = append(w.State.SampleIDs, sample.SampleID)
+	}
+	return samples, nil
+}
+
+// SeparateSpeakers separates the speakers of a sample and waits until they are separated or ctx is done. The
+// separation is only started if it wasn't already, so it can be called again to resume waiting.
+//
+// It returns the SpeakerSeparation from which the speakers to keep are chosen with SelectSpeakers, or an error
+// wrapping ErrSpeakerSeparationFailed if the speakers couldn't be separated.
+func (w *PVCWorkflow) SeparateSpeakers(ctx context.Context, sampleID string) (SpeakerSeparation, error) {
+	if err := w.checkStep("separate speakers", PVCStepSamples); err != nil {
+		return SpeakerSeparation{}, err
+	}
+
+	separation, err := w.client.GetSpeakerSeparation(w.State.VoiceID, sampleID, WithRequestContext(ctx))
+	if err != nil {
+		return SpeakerSeparation{}, err
+	}
+	if separation.Status == SpeakerSeparationNotStarted || separation.Status == SpeakerSeparationFailed {
+		if err := w.client.StartSpeakerSeparation(w.State.VoiceID, sampleID, WithRequestContext(ctx)); err != nil {
+			return SpeakerSeparation{}, err
+		}
+	}
+
+	err = pollWithBackoff(ctx, w.pollInterval(), w.MaxPollInterval, func() (bool, error) {
+		var err error
+		separation, err = w.client.GetSpeakerSeparation(w.State.VoiceID, sampleID, WithRequestContext(ctx))
+		if err != nil {
+			return false, err
+		}
+		switch separation.Status {
+		case SpeakerSeparationCompleted:
+			return true, nil
+		case SpeakerSeparationFailed:
+			return true, ErrSpeakerSeparationFailed
+		}
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, ErrSpeakerSeparationFailed) {
+		return SpeakerSeparation{}, err
+	}
+	return separation, err
+}
+
+// SelectSpeakers selects the speakers of a sample whose audio is used for training, after SeparateSpeakers.
+func (w *PVCWorkflow) SelectSpeakers(sampleID string, speakerIDs []string, opts ...RequestOption) error {
+	if err := w.checkStep("select speakers", PVCStepSamples); err != nil {
+		return err
+	}
+	return w.client.UpdatePVCSample(w.State.VoiceID, sampleID, UpdatePVCSampleRequest{SelectedSpeakerIDs: speakerIDs}, opts...)
+}
+
+// startVerification moves the workflow to PVCStepVerification once samples have been added. No samples can be
+// added once verification has started.
+func (w *PVCWorkflow) startVerification(action string) error {
+	if err := w.checkStep(action, PVCStepSamples, PVCStepVerification); err != nil {
+		return err
+	}
+	if len(w.State.SampleIDs) == 0 {
+		return fmt.Errorf("%w: can't %s before samples are added", ErrPVCStep, action)
+	}
+	w.State.Step = PVCStepVerification
+	return nil
+}
+
+// Captcha retrieves the image of the text the speaker must read to prove their consent, moving the workflow to
+// PVCStepVerification. The recording is uploaded with VerifyCaptcha.
+func (w *PVCWorkflow) Captcha(opts ...RequestOption) ([]byte, error) {
+	if err := w.startVerification("get captcha"); err != nil {
+		return nil, err
+	}
+	return w.client.GetPVCCaptcha(w.State.VoiceID, opts...)
+}
+
+// VerifyCaptcha uploads the recording of the speaker reading the captcha and moves the workflow to
+// PVCStepTraining if the voice is verified. It can be called again with another recording if it fails.
+func (w *PVCWorkflow) VerifyCaptcha(recording io.Reader, fileName string, opts ...RequestOption) error {
+	if err := w.startVerification("verify captcha"); err != nil {
+		return err
+	}
+	if err := w.client.VerifyPVCCaptcha(w.State.VoiceID, recording, fileName, opts...); err != nil {
+		return err
+	}
+	w.State.Step = PVCStepTraining
+	return nil
+}
+
+// RequestVerification requests the manual verification of the voice and moves the workflow to PVCStepTraining.
+// Training fails until the ElevenLabs team has approved the voice.
+func (w *PVCWorkflow) RequestVerification(verificationReq PVCVerificationRequest, opts ...RequestOption) error {
+	if err := w.startVerification("request verification"); err != nil {
+		return err
+	}
+	if err := w.client.RequestPVCVerification(w.State.VoiceID, verificationReq, opts...); err != nil {
+		return err
+	}
+	w.State.Step = PVCStepTraining
+	return nil
+}
+
+// Train starts training the voice for a model, whose ID may be empty to use the default. Its completion is
+// awaited with WaitForTraining.
+func (w *PVCWorkflow) Train(modelID string, opts ...RequestOption) error {
+	if err := w.checkStep("train", PVCStepTraining); err != nil {
+		return err
+	}
+	if err := w.client.TrainPVCVoice(w.State.VoiceID, modelID, opts...); err != nil {
+		return err
+	}
+	w.State.TrainingStarted = true
+	w.State.ModelID = modelID
+	return nil
+}
+
+// WaitForTraining polls the FineTuning.FineTuningState of the voice until it is trained, training fails or ctx is
+// done. It moves the workflow to PVCStepDone when the voice is trained.
+//
+// It returns the trained Voice, or an error wrapping ErrFineTuningFailed if training failed.
+func (w *PVCWorkflow) WaitForTraining(ctx context.Context) (Voice, error) {
+	if err := w.checkStep("wait for training", PVCStepTraining, PVCStepDone); err != nil {
+		return Voice{}, err
+	}
+	if !w.State.TrainingStarted {
+		return Voice{}, fmt.Errorf("%w: can't wait for training before it is started", ErrPVCStep)
+	}
+
+	var voice Voice
+	err := pollWithBackoff(ctx, w.pollInterval(), w.MaxPollInterval, func() (bool, error) {
+		var err error
+		voice, err = w.client.getVoice(ctx, w.State.VoiceID, nil)
+		if err != nil {
+			return false, err
+		}
+		switch voice.FineTuning.FineTuningState {
+		case FineTuningStateFineTuned:
+			return true, nil
+		case FineTuningStateFailed:
+			return true, fmt.Errorf("%w: %s", ErrFineTuningFailed, strings.Join(voice.FineTuning.VerificationFailures, "; "))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return Voice{}, err
+	}
+	w.State.Step = PVCStepDone
+	return voice, nil
+}
+
+func (w *PVCWorkflow) pollInterval() time.Duration {
+	if w.PollInterval <= 0 {
+		return defaultPVCPollInterval
+	}
+	return w.PollInterval
+}
@@ -0,0 +1,201 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+// testPVCServer stands in for the professional voice cloning endpoints. Speaker separation and training each
+// complete on the second status check.
+func testPVCServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+	separationChecks, trainingChecks := 0, 0
+	separationStarted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		requests = append(requests, route)
+		switch route {
+		case "POST /voices/pvc":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["name"] != "Talent" || body["language"] != "en" {
+				t.Errorf("Server: unexpected voice %v", body)
+			}
+			w.Write([]byte(`{"voice_id": "pvc1"}`))
+		case "POST /voices/pvc/pvc1/samples":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse samples: %v", err)
+			}
+			if len(r.MultipartForm.File["files"]) != 2 || r.FormValue("remove_background_noise") != "true" {
+				t.Errorf("Server: unexpected samples form %v", r.MultipartForm)
+			}
+			w.Write([]byte(`[{"sample_id": "s1", "file_name": "a.mp3"}, {"sample_id": "s2", "file_name": "b.mp3"}]`))
+		case "GET /voices/pvc/pvc1/samples/s1/speakers":
+			status := elevenlabs.SpeakerSeparationNotStarted
+			if separationStarted {
+				separationChecks++
+				status = elevenlabs.SpeakerSeparationPending
+				if separationChecks > 1 {
+					status = elevenlabs.SpeakerSeparationCompleted
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"voice_id": "pvc1", "sample_id": "s1", "status": status,
+				"speakers": map[string]interface{}{
+					"sp1": map[string]interface{}{"speaker_id": "sp1", "duration_secs": 42.5, "utterances": []map[string]float64{{"start": 0, "end": 42.5}}},
+					"sp2": map[string]interface{}{"speaker_id": "sp2", "duration_secs": 3.1},
+				},
+			})
+		case "POST /voices/pvc/pvc1/samples/s1/separate-speakers":
+			separationStarted = true
+			w.Write([]byte(`{"status": "ok"}`))
+		case "POST /voices/pvc/pvc1/samples/s1":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if !reflect.DeepEqual(body, map[string]interface{}{"selected_speaker_ids": []interface{}{"sp1"}}) {
+				t.Errorf("Server: unexpected sample update %v", body)
+			}
+			w.Write([]byte(`{"status": "ok"}`))
+		case "GET /voices/pvc/pvc1/captcha":
+			w.Write([]byte("captcha image"))
+		case "POST /voices/pvc/pvc1/captcha":
+			f, header, err := r.FormFile("recording")
+			if err != nil || header.Filename != "captcha.wav" {
+				t.Errorf("Server: unexpected recording %v, %v", header, err)
+				return
+			}
+			f.Close()
+			w.Write([]byte(`{"status": "ok"}`))
+		case "POST /voices/pvc/pvc1/train":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["model_id"] != "eleven_multilingual_v2" {
+				t.Errorf("Server: unexpected training request %v", body)
+			}
+			w.Write([]byte(`{"status": "ok"}`))
+		case "GET /voices/pvc1":
+			trainingChecks++
+			state := elevenlabs.FineTuningStateFineTuning
+			if trainingChecks > 1 {
+				state = elevenlabs.FineTuningStateFineTuned
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"voice_id": "pvc1", "name": "Talent", "fine_tuning": map[string]string{"finetuning_state": state}})
+		default:
+			t.Errorf("Server: unexpected request %s", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server, &requests
+}
+
+func TestPVCWorkflow(t *testing.T) {
+	server, requests := testPVCServer(t)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"a.mp3", "b.mp3"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("sample"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	wf := client.NewPVCWorkflow()
+	wf.PollInterval = time.Millisecond
+	if _, err := wf.Captcha(); !errors.Is(err, elevenlabs.ErrPVCStep) {
+		t.Errorf("Expected ErrPVCStep before the voice is created, got %v", err)
+	}
+	if err := wf.Create(elevenlabs.CreatePVCVoiceRequest{Name: "Talent", Language: "en"}); err != nil {
+		t.Fatalf("Expected no errors creating voice, got error: %q", err)
+	}
+	if _, err := wf.AddSamples(elevenlabs.AddPVCSamplesRequest{FilePaths: paths, RemoveBackgroundNoise: true}); err != nil {
+		t.Fatalf("Expected no errors adding samples, got error: %q", err)
+	}
+
+	separation, err := wf.SeparateSpeakers(context.Background(), "s1")
+	if err != nil {
+		t.Fatalf("Expected no errors separating speakers, got error: %q", err)
+	}
+	if separation.Status != elevenlabs.SpeakerSeparationCompleted || separation.Speakers["sp1"].Utterances[0].End != 42.5 {
+		t.Errorf("Unexpected SpeakerSeparation: %+v", separation)
+	}
+	if err := wf.SelectSpeakers("s1", []string{"sp1"}); err != nil {
+		t.Fatalf("Expected no errors selecting speakers, got error: %q", err)
+	}
+
+	if captcha, err := wf.Captcha(); err != nil || string(captcha) != "captcha image" {
+		t.Fatalf("Unexpected captcha %q, %v", captcha, err)
+	}
+	if _, err := wf.AddSamples(elevenlabs.AddPVCSamplesRequest{FilePaths: paths}); !errors.Is(err, elevenlabs.ErrPVCStep) {
+		t.Errorf("Expected ErrPVCStep adding samples during verification, got %v", err)
+	}
+	if err := wf.VerifyCaptcha(strings.NewReader("recording"), "captcha.wav"); err != nil {
+		t.Fatalf("Expected no errors verifying captcha, got error: %q", err)
+	}
+	if err := wf.Train("eleven_multilingual_v2"); err != nil {
+		t.Fatalf("Expected no errors starting training, got error: %q", err)
+	}
+
+	// The workflow is resumed from its saved state, e.g. in another process.
+	saved, err := json.Marshal(wf.State)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state elevenlabs.PVCState
+	if err := json.Unmarshal(saved, &state); err != nil {
+		t.Fatal(err)
+	}
+	expState := elevenlabs.PVCState{
+		Step:            elevenlabs.PVCStepTraining,
+		VoiceID:         "pvc1",
+		SampleIDs:       []string{"s1", "s2"},
+		TrainingStarted: true,
+		ModelID:         "eleven_multilingual_v2",
+	}
+	if !reflect.DeepEqual(state, expState) {
+		t.Errorf("Expected state %+v, got %+v", expState, state)
+	}
+
+	wf = client.ResumePVCWorkflow(state)
+	wf.PollInterval = time.Millisecond
+	voice, err := wf.WaitForTraining(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no errors waiting for training, got error: %q", err)
+	}
+	if voice.FineTuning.FineTuningState != elevenlabs.FineTuningStateFineTuned || wf.State.Step != elevenlabs.PVCStepDone {
+		t.Errorf("Unexpected voice %+v at step %q", voice.FineTuning, wf.State.Step)
+	}
+
+	expRequests := []string{
+		"POST /voices/pvc",
+		"POST /voices/pvc/pvc1/samples",
+		"GET /voices/pvc/pvc1/samples/s1/speakers",
+		"POST /voices/pvc/pvc1/samples/s1/separate-speakers",
+		"GET /voices/pvc/pvc1/samples/s1/speakers",
+		"GET /voices/pvc/pvc1/samples/s1/speakers",
+		"POST /voices/pvc/pvc1/samples/s1",
+		"GET /voices/pvc/pvc1/captcha",
+		"POST /voices/pvc/pvc1/captcha",
+		"POST /voices/pvc/pvc1/train",
+		"GET /voices/pvc1",
+		"GET /voices/pvc1",
+	}
+	if !reflect.DeepEqual(*requests, expRequests) {
+		t.Errorf("Expected requests %q, got %q", expRequests, *requests)
+	}
+}
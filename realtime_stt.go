@@ -0,0 +1,198 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// RealtimeSpeechToTextSession represents an open realtime speech-to-text WebSocket session.
+//
+// Audio is sent with SendAudio and transcripts are received from the channel returned by Transcripts.
+// The channel is closed when the session ends, after which Err reports why it ended.
+type RealtimeSpeechToTextSession struct {
+	conn       *websocket.Conn
+	sampleRate int
+
+	writeMu     sync.Mutex
+	transcripts chan RealtimeTranscript
+	done        chan struct{}
+	closeOnce   sync.Once
+
+	mu        sync.Mutex
+	sessionID string
+	err       error
+}
+
+type realtimeAudioChunk struct {
+	MessageType string `json:"message_type"`
+	AudioBase64 string `json:"audio_base_64"`
+	Commit      bool   `json:"commit"`
+	SampleRate  int    `json:"sample_rate,omitempty"`
+}
+
+type realtimeServerMessage struct {
+	RealtimeTranscript
+	SessionID string `json:"session_id"`
+	Error     string `json:"error"`
+}
+
+// RealtimeSpeechToText opens a realtime speech-to-text session over WebSocket.
+//
+// It takes a RealtimeSpeechToTextConfig argument that contains the session settings and an optional list of
+// RequestOption 'opts'. When a context is provided with WithRequestContext, cancelling it closes the session.
+// Otherwise the client's timeout only applies to opening the connection.
+//
+// It returns the open session or an error.
+func (c *Client) RealtimeSpeechToText(config RealtimeSpeechToTextConfig, opts ...RequestOption) (*RealtimeSpeechToTextSession, error) {
+	options := c.requestOptions(opts)
+	queries := append(config.queries(), options.queries...)
+	conn, err := c.dialWebSocket(options.ctx, "/speech-to-text/realtime", queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &RealtimeSpeechToTextSession{
+		conn:        conn,
+		sampleRate:  sampleRateFromFormat(config.AudioFormat),
+		transcripts: make(chan RealtimeTranscript, 16),
+		done:        make(chan struct{}),
+	}
+	go s.readLoop()
+	go s.closeOnDone(options.ctx)
+	return s, nil
+}
+
+// sampleRateFromFormat extracts the sample rate from an audio format such as pcm_16000, returning 0 if unknown.
+func sampleRateFromFormat(format string) int {
+	i := strings.LastIndex(format, "_")
+	if i < 0 {
+		return 0
+	}
+	rate, err := strconv.Atoi(format[i+1:])
+	if err != nil {
+		return 0
+	}
+	return rate
+}
+
+// Transcripts returns the channel on which partial and committed transcripts are delivered.
+func (s *RealtimeSpeechToTextSession) Transcripts() <-chan RealtimeTranscript {
+	return s.transcripts
+}
+
+// SessionID returns the ID assigned to the session by the server, or an empty string if it has not been received yet.
+func (s *RealtimeSpeechToTextSession) SessionID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessionID
+}
+
+// Err returns the error that ended the session, if any. It should be called after the transcripts channel is closed.
+func (s *RealtimeSpeechToTextSession) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// SendAudio sends a chunk of audio encoded in the format the session was opened with.
+func (s *RealtimeSpeechToTextSession) SendAudio(chunk []byte) error {
+	return s.send(chunk, false)
+}
+
+// Commit asks the server to commit the audio sent so far, producing a committed transcript.
+// It is required when the session uses the RealtimeCommitManual strategy.
+func (s *RealtimeSpeechToTextSession) Commit() error {
+	return s.send(nil, true)
+}
+
+func (s *RealtimeSpeechToTextSession) send(chunk []byte, commit bool) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.conn.WriteJSON(realtimeAudioChunk{
+		MessageType: "input_audio_chunk",
+		AudioBase64: base64.StdEncoding.EncodeToString(chunk),
+		Commit:      commit,
+		SampleRate:  s.sampleRate,
+	})
+}
+
+// Close ends the session. Transcripts that have not been received yet are discarded.
+func (s *RealtimeSpeechToTextSession) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.writeMu.Lock()
+		_ = s.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		s.writeMu.Unlock()
+		err = s.conn.Close()
+	})
+	return err
+}
+
+func (s *RealtimeSpeechToTextSession) closeOnDone(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		s.setErr(ctx.Err())
+		s.Close()
+	case <-s.done:
+	}
+}
+
+func (s *RealtimeSpeechToTextSession) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+func (s *RealtimeSpeechToTextSession) readLoop() {
+	defer close(s.transcripts)
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-s.done:
+			default:
+				if !isExpectedClose(err) {
+					s.setErr(err)
+				}
+				// The server ended the session, which releases the context goroutine.
+				s.Close()
+			}
+			return
+		}
+
+		var msg realtimeServerMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.setErr(err)
+			s.Close()
+			return
+		}
+
+		switch msg.MessageType {
+		case "session_started":
+			s.mu.Lock()
+			s.sessionID = msg.SessionID
+			s.mu.Unlock()
+		case RealtimePartialTranscript, RealtimeCommittedTranscript, RealtimeCommittedTranscriptWithTimestamps:
+			select {
+			case s.transcripts <- msg.RealtimeTranscript:
+			case <-s.done:
+				return
+			}
+		default:
+			if msg.Error != "" {
+				s.setErr(&WebSocketError{Type: msg.MessageType, Message: msg.Error})
+				s.Close()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+// testWebSocketServer starts a local WebSocket stand-in that checks the API key and query string, then hands
+// the upgraded connection to handle.
+func testWebSocketServer(t *testing.T, expectedQueryStr string, handle func(conn *websocket.Conn)) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("xi-api-key"); got != mockAPIKey {
+			t.Errorf("Server: expected API Key %q, got %q", mockAPIKey, got)
+		}
+		if expectedQueryStr != "" && r.URL.RawQuery != expectedQueryStr {
+			t.Errorf("Server: expected query string %q, got %q", expectedQueryStr, r.URL.RawQuery)
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Server: failed to upgrade connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		handle(conn)
+	}))
+}
+
+func TestRealtimeSpeechToText(t *testing.T) {
+	includeTimestamps := true
+	server := testWebSocketServer(t, "audio_format=pcm_16000&commit_strategy=manual&include_timestamps=true&model_id=scribe_v2_realtime", func(conn *websocket.Conn) {
+		conn.WriteJSON(map[string]any{"message_type": "session_started", "session_id": "TestSessionID"})
+
+		var chunk map[string]any
+		if err := conn.ReadJSON(&chunk); err != nil {
+			t.Errorf("Server: failed to read audio chunk: %v", err)
+			return
+		}
+		audio, _ := base64.StdEncoding.DecodeString(chunk["audio_base_64"].(string))
+		if string(audio) != "audio" || chunk["commit"] != false || chunk["sample_rate"] != float64(16000) {
+			t.Errorf("Server: unexpected audio chunk %v", chunk)
+		}
+		conn.WriteJSON(map[string]any{"message_type": "partial_transcript", "text": "hel"})
+
+		if err := conn.ReadJSON(&chunk); err != nil {
+			t.Errorf("Server: failed to read commit: %v", err)
+			return
+		}
+		if chunk["commit"] != true {
+			t.Errorf("Server: expected commit message, got %v", chunk)
+		}
+		conn.WriteMessage(websocket.TextMessage, []byte(`{
+			"message_type": "committed_transcript_with_timestamps",
+			"text": "hello",
+			"language_code": "en",
+			"words": [{"text": "hello", "start": 0.1, "end": 0.5, "type": "word"}]
+		}`))
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	session, err := client.RealtimeSpeechToText(elevenlabs.RealtimeSpeechToTextConfig{
+		ModelID:           "scribe_v2_realtime",
+		AudioFormat:       "pcm_16000",
+		CommitStrategy:    elevenlabs.RealtimeCommitManual,
+		IncludeTimestamps: &includeTimestamps,
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	defer session.Close()
+
+	if err := session.SendAudio([]byte("audio")); err != nil {
+		t.Fatalf("Expected no errors sending audio, got error: %q", err)
+	}
+	partial := <-session.Transcripts()
+	if partial.Committed() || partial.Text != "hel" {
+		t.Errorf("Unexpected partial transcript: %+v", partial)
+	}
+	if err := session.Commit(); err != nil {
+		t.Fatalf("Expected no errors committing, got error: %q", err)
+	}
+	committed := <-session.Transcripts()
+	if !committed.Committed() || committed.Text != "hello" || committed.LanguageCode != "en" ||
+		len(committed.Words) != 1 || committed.Words[0].End != 0.5 {
+		t.Errorf("Unexpected committed transcript: %+v", committed)
+	}
+	if _, ok := <-session.Transcripts(); ok {
+		t.Errorf("Expected transcripts channel to be closed")
+	}
+	if err := session.Err(); err != nil {
+		t.Errorf("Expected no session error, got %q", err)
+	}
+	if session.SessionID() != "TestSessionID" {
+		t.Errorf("Expected session ID %q, got %q", "TestSessionID", session.SessionID())
+	}
+}
+
+func TestRealtimeSpeechToTextServerError(t *testing.T) {
+	server := testWebSocketServer(t, "", func(conn *websocket.Conn) {
+		conn.WriteJSON(map[string]any{"message_type": "quota_exceeded", "error": "Quota exceeded"})
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	session, err := client.RealtimeSpeechToText(elevenlabs.RealtimeSpeechToTextConfig{ModelID: "scribe_v2_realtime"})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	for range session.Transcripts() {
+	}
+	var wsErr *elevenlabs.WebSocketError
+	if !errors.As(session.Err(), &wsErr) || wsErr.Type != "quota_exceeded" {
+		t.Errorf("Expected error of type %T, got %T: %v", wsErr, session.Err(), session.Err())
+	}
+}
+
+func TestRealtimeSpeechToTextHandshakeError(t *testing.T) {
+	server := testServer(t, testServerConfig{
+		expectedMethod: http.MethodGet,
+		statusCode:     http.StatusUnauthorized,
+		responseBody:   testRespBodies["TestAPIErrorOnBadRequestAndUnauthorized"],
+	})
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	_, err := client.RealtimeSpeechToText(elevenlabs.RealtimeSpeechToTextConfig{})
+	if _, ok := err.(*elevenlabs.APIError); !ok {
+		t.Errorf("Expected error of type %T, got %T: %v", &elevenlabs.APIError{}, err, err)
+	}
+}
+
+func TestRealtimeSpeechToTextContextCancel(t *testing.T) {
+	server := testWebSocketServer(t, "", func(conn *websocket.Conn) {
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	session, err := client.RealtimeSpeechToText(elevenlabs.RealtimeSpeechToTextConfig{}, elevenlabs.WithRequestContext(ctx))
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	cancel()
+	for range session.Transcripts() {
+	}
+	if !errors.Is(session.Err(), context.Canceled) {
+		t.Errorf("Expected context canceled error, got %v", session.Err())
+	}
+}
+
+func TestRealtimeSpeechToTextServerClose(t *testing.T) {
+	server := testWebSocketServer(t, "", func(conn *websocket.Conn) {
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	session, err := client.RealtimeSpeechToText(elevenlabs.RealtimeSpeechToTextConfig{}, elevenlabs.WithRequestContext(ctx))
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	for range session.Transcripts() {
+	}
+
+	// The session ended with the server, so cancelling the context afterwards has no effect.
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	if err := session.Err(); err != nil {
+		t.Errorf("Expected no errors after the server closed the session, got error: %q", err)
+	}
+}
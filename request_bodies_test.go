@@ -472,4 +472,116 @@ var testRespBodies = map[string][]byte{
   "request_id": "req_12345",
   "message": "Transcription request submitted successfully. Results will be sent to your webhook."
 }`),
+	"TestForcedAlignment": []byte(`{
+  "characters": [
+    {"text": "H", "start": 0.0, "end": 0.1},
+    {"text": "i", "start": 0.1, "end": 0.2}
+  ],
+  "words": [
+    {"text": "Hi", "start": 0.0, "end": 0.2, "loss": 0.12}
+  ],
+  "loss": 0.12
+}`),
+	"TestGetDubbingResource": []byte(`{
+  "id": "TestDubbingID",
+  "version": 1,
+  "source_language": "en",
+  "target_languages": ["es"],
+  "speaker_segments": {
+    "seg1": {
+      "id": "seg1",
+      "start_time": 0.5,
+      "end_time": 1.8,
+      "text": "Hello everyone",
+      "dubs": {
+        "es": {"start_time": 0.5, "end_time": 1.9, "text": "Hola a todos."}
+      }
+    }
+  }
+}`),
+	"TestGetChapter": []byte(`{
+  "chapter_id": "c1",
+  "name": "Chapter 1",
+  "state": "default",
+  "conversion_progress": 1,
+  "can_be_downloaded": true,
+  "content": {
+    "blocks": [
+      {"block_id": "b1", "sub_type": "p", "nodes": [{"type": "tts_node", "voice_id": "v1", "text": "It was a dark night."}]},
+      {"block_id": "b2", "sub_type": "p", "nodes": [{"type": "tts_node", "voice_id": "v1", "text": "\"Who goes there?\""}]}
+    ]
+  }
+}`),
+	"TestTextToDialogueWithTimestamps": []byte(`{
+  "audio_base64": "YXVkaW8=",
+  "alignment": {
+    "characters": ["H", "i", "Y", "o"],
+    "character_start_times_seconds": [0.0, 0.1, 0.6, 0.7],
+    "character_end_times_seconds": [0.1, 0.2, 0.7, 0.8]
+  },
+  "voice_segments": [
+    {"voice_id": "v1", "start_time_seconds": 0.0, "end_time_seconds": 0.2, "character_start_index": 0, "character_end_index": 2, "dialogue_input_index": 0},
+    {"voice_id": "v2", "start_time_seconds": 0.6, "end_time_seconds": 0.8, "character_start_index": 2, "character_end_index": 4, "dialogue_input_index": 1}
+  ]
+}`),
+	"TestGetAgent": []byte(`{
+  "agent_id": "a1",
+  "name": "Support",
+  "conversation_config": {
+    "asr": {"quality": "high", "provider": "elevenlabs", "user_input_audio_format": "pcm_16000", "keywords": []},
+    "turn": {"turn_timeout": 7},
+    "tts": {"model_id": "eleven_flash_v2", "voice_id": "v1", "agent_output_audio_format": "pcm_16000", "optimize_streaming_latency": 3, "stability": 0.5, "similarity_boost": 0.8, "speed": 1},
+    "conversation": {"max_duration_seconds": 600, "client_events": ["audio", "interruption"]},
+    "agent": {
+      "first_message": "Hi, how can I help?",
+      "language": "en",
+      "prompt": {
+        "prompt": "You are a helpful support agent.",
+        "llm": "gpt-4o-mini",
+        "temperature": 0.2,
+        "max_tokens": -1,
+        "tools": [
+          {
+            "type": "webhook",
+            "name": "get_order",
+            "description": "Looks up an order",
+            "response_timeout_secs": 20,
+            "api_schema": {
+              "url": "https://example.com/orders",
+              "method": "POST",
+              "request_body_schema": {"type": "object", "properties": {"order_id": {"type": "string"}}, "required": ["order_id"]}
+            }
+          }
+        ],
+        "knowledge_base": [{"type": "url", "name": "FAQ", "id": "kb1", "usage_mode": "auto"}]
+      }
+    }
+  },
+  "metadata": {"created_at_unix_secs": 1700000000},
+  "platform_settings": {"auth": {"enable_auth": false}},
+  "tags": []
+}`),
+	"TestGetConversation": []byte(`{
+  "agent_id": "a1",
+  "conversation_id": "conv1",
+  "status": "done",
+  "transcript": [
+    {"role": "agent", "message": "Hi, how can I help?", "time_in_call_secs": 0},
+    {"role": "user", "message": "Where is my order?", "time_in_call_secs": 3},
+    {"role": "agent", "message": "", "time_in_call_secs": 5, "tool_calls": [{"request_id": "r1", "tool_name": "get_order", "params_as_json": "{\"order_id\":\"42\"}"}]},
+    {"role": "agent", "message": "It ships tomorrow.", "time_in_call_secs": 6, "tool_results": [{"request_id": "r1", "tool_name": "get_order", "result_value": "shipping", "is_error": false}]}
+  ],
+  "metadata": {"start_time_unix_secs": 1700000000, "call_duration_secs": 9, "cost": 120, "termination_reason": "end_call tool"},
+  "analysis": {
+    "call_successful": "success",
+    "transcript_summary": "The user asked about an order.",
+    "evaluation_criteria_results": {"resolved": {"criteria_id": "resolved", "result": "success", "rationale": "The order status was given."}},
+    "data_collection_results": {"order_id": {"data_collection_id": "order_id", "value": "42", "rationale": "Mentioned by the user."}}
+  },
+  "has_audio": true,
+  "has_user_audio": true,
+  "has_response_audio": true
+}`),
+	"TestDesignVoice":     []byte(`{"previews": [{"generated_voice_id": "gen1", "audio_base_64": "cHJldmlldw==", "media_type": "audio/mpeg", "duration_secs": 6.2, "language": "en"}, {"generated_voice_id": "gen2", "audio_base_64": "cHJldmlldzI=", "media_type": "audio/mpeg", "duration_secs": 5.9, "language": "en"}], "text": "Gather round, and I shall tell you a tale of the old days, when the sea still sang to those who would listen."}`),
+	"TestGetSharedVoices": []byte(`{"voices": [{"public_owner_id": "owner1", "voice_id": "sv1", "date_unix": 1700000000, "name": "Lighthouse Keeper", "accent": "british", "gender": "male", "age": "old", "descriptive": "calm", "use_case": "narrative_story", "category": "professional", "language": "en", "locale": "en-GB", "description": "A weathered storyteller.", "preview_url": "https://example.com/sv1.mp3", "usage_character_count_1y": 1200000, "usage_character_count_7d": 30000, "cloned_by_count": 412, "liked_by_count": 37, "rate": 1, "free_users_allowed": true, "live_moderation_enabled": false, "featured": true, "notice_period": 30}], "has_more": true, "last_sort_id": "sv1"}`),
 }
@@ -0,0 +1,429 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+// Formats of voice samples recognized by AddEditVoiceRequest.Validate.
+const (
+	SampleFormatMP3  = "mp3"
+	SampleFormatWAV  = "wav"
+	SampleFormatFLAC = "flac"
+	SampleFormatOGG  = "ogg"
+	SampleFormatM4A  = "m4a"
+)
+
+const (
+	defaultMaxSamples          = 25
+	defaultMaxSampleSize       = 10 << 20
+	defaultMaxTotalSampleSize  = 50 << 20
+	defaultMinSamplesDuration  = 60
+	defaultMaxSampleSilence    = 0.5
+	sampleSilenceThreshold     = 0.00316 // -50 dBFS
+	sampleSilenceWindowsPerSec = 50      // 20 ms windows
+)
+
+// SampleValidationLimits represents the limits checked by AddEditVoiceRequest.Validate. Zero fields take the
+// defaults, which follow the recommendations for instant voice cloning.
+type SampleValidationLimits struct {
+	MaxFiles            int     // Defaults to 25
+	MaxFileSize         int64   // In bytes. Defaults to 10 MiB.
+	MaxTotalSize        int64   // In bytes. Defaults to 50 MiB.
+	MinTotalDurationSec float64 // Defaults to 60 seconds. Only checked when the duration of every sample is known.
+	MaxSilenceRatio     float64 // Between 0 and 1. Defaults to 0.5.
+}
+
+// SampleReport represents the result of the validation of a voice sample.
+type SampleReport struct {
+	FileName     string
+	Format       string   // One of the SampleFormat constants, or empty if the format isn't supported
+	SizeBytes    int64    // Only a lower bound for Files larger than the size limit that don't implement io.Seeker
+	DurationSecs float64  // Zero if unknown. Only MP3 and WAV samples are measured.
+	SilenceRatio *float64 // Share of 20 ms windows quieter than -50 dBFS. Only measured for uncompressed WAV samples.
+	Problems     []string
+}
+
+// SampleValidationReport represents the result of AddEditVoiceRequest.Validate. Samples are listed in the order
+// they are uploaded: those of FilePaths, then those of Files.
+type SampleValidationReport struct {
+	Samples           []SampleReport
+	TotalSizeBytes    int64
+	TotalDurationSecs float64 // Sum of the known sample durations
+	Problems          []string
+}
+
+// Valid reports whether neither the request nor any sample has problems.
+func (r SampleValidationReport) Valid() bool {
+	if len(r.Problems) > 0 {
+		return false
+	}
+	for _, sample := range r.Samples {
+		if len(sample.Problems) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the samples of the request against limits before they are uploaded: the number of files, their
+// sizes, their formats sniffed from their headers, their durations and the share of silence they contain. No
+// request is made.
+//
+// The content of Files must be read to be checked, up to one byte past the size limit. Contents implementing
+// io.Seeker are moved back to their position afterwards; in the others, the bytes read are put back in front of
+// the rest of the content, so that the request can still be sent.
+//
+// It returns a SampleValidationReport describing the problems found, or an error if a sample couldn't be read.
+func (r *AddEditVoiceRequest) Validate(limits SampleValidationLimits) (SampleValidationReport, error) {
+	limits = limits.withDefaults()
+	var report SampleValidationReport
+
+	for _, path := range r.FilePaths {
+		sample, err := validateSampleFile(path, limits)
+		if err != nil {
+			return SampleValidationReport{}, err
+		}
+		report.Samples = append(report.Samples, sample)
+	}
+	for i, file := range r.Files {
+		if file.Content == nil {
+			return SampleValidationReport{}, fmt.Errorf("sample %q has no content", file.FileName)
+		}
+		seeker, canSeek := file.Content.(io.Seeker)
+		var start int64
+		if canSeek {
+			var err error
+			if start, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+				return SampleValidationReport{}, fmt.Errorf("failed to seek sample %q: %w", file.FileName, err)
+			}
+		}
+		content, err := io.ReadAll(io.LimitReader(file.Content, limits.MaxFileSize+1))
+		if err != nil {
+			return SampleValidationReport{}, fmt.Errorf("failed to read sample %q: %w", file.FileName, err)
+		}
+
+		size, exact := int64(len(content)), true
+		if canSeek {
+			if size > limits.MaxFileSize {
+				var end int64
+				if end, err = seeker.Seek(0, io.SeekEnd); err == nil {
+					size = end - start
+				}
+			}
+			if err == nil {
+				_, err = seeker.Seek(start, io.SeekStart)
+			}
+			if err != nil {
+				return SampleValidationReport{}, fmt.Errorf("failed to rewind sample %q: %w", file.FileName, err)
+			}
+		} else {
+			r.Files[i].Content = io.MultiReader(bytes.NewReader(content), file.Content)
+			exact = size <= limits.MaxFileSize
+		}
+		report.Samples = append(report.Samples, validateSample(file.FileName, content, size, exact, limits))
+	}
+
+	durationsKnown := true
+	for _, sample := range report.Samples {
+		report.TotalSizeBytes += sample.SizeBytes
+		report.TotalDurationSecs += sample.DurationSecs
+		durationsKnown = durationsKnown && sample.DurationSecs > 0
+	}
+	if len(report.Samples) == 0 {
+		report.Problems = append(report.Problems, "no samples")
+	}
+	if len(report.Samples) > limits.MaxFiles {
+		report.Problems = append(report.Problems, fmt.Sprintf("%d samples exceed the limit of %d", len(report.Samples), limits.MaxFiles))
+	}
+	if report.TotalSizeBytes > limits.MaxTotalSize {
+		report.Problems = append(report.Problems, fmt.Sprintf("total size of %d bytes exceeds the limit of %d bytes", report.TotalSizeBytes, limits.MaxTotalSize))
+	}
+	if len(report.Samples) > 0 && durationsKnown && report.TotalDurationSecs < limits.MinTotalDurationSec {
+		report.Problems = append(report.Problems, fmt.Sprintf("total duration of %.1fs is shorter than the minimum of %.1fs", report.TotalDurationSecs, limits.MinTotalDurationSec))
+	}
+	return report, nil
+}
+
+func (l SampleValidationLimits) withDefaults() SampleValidationLimits {
+	if l.MaxFiles <= 0 {
+		l.MaxFiles = defaultMaxSamples
+	}
+	if l.MaxFileSize <= 0 {
+		l.MaxFileSize = defaultMaxSampleSize
+	}
+	if l.MaxTotalSize <= 0 {
+		l.MaxTotalSize = defaultMaxTotalSampleSize
+	}
+	if l.MinTotalDurationSec <= 0 {
+		l.MinTotalDurationSec = defaultMinSamplesDuration
+	}
+	if l.MaxSilenceRatio <= 0 {
+		l.MaxSilenceRatio = defaultMaxSampleSilence
+	}
+	return l
+}
+
+// validateSampleFile validates the file at path. Files larger than the size limit are only sniffed, not read.
+func validateSampleFile(path string, limits SampleValidationLimits) (SampleReport, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return SampleReport{}, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return SampleReport{}, err
+	}
+
+	var content []byte
+	if info.Size() > limits.MaxFileSize {
+		content = make([]byte, 12)
+		n, err := io.ReadFull(f, content)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return SampleReport{}, err
+		}
+		content = content[:n]
+	} else if content, err = io.ReadAll(f); err != nil {
+		return SampleReport{}, err
+	}
+	return validateSample(filepath.Base(path), content, info.Size(), true, limits), nil
+}
+
+// validateSample validates a sample of the given size, whose content is either complete or only its header if
+// the sample is too large. The size of samples too large is only a lower bound if exact is false.
+func validateSample(fileName string, content []byte, size int64, exact bool, limits SampleValidationLimits) SampleReport {
+	sample := SampleReport{FileName: fileName, Format: sniffSampleFormat(content), SizeBytes: size}
+	if size == 0 {
+		sample.Problems = append(sample.Problems, "empty file")
+		return sample
+	}
+	if size > limits.MaxFileSize {
+		if exact {
+			sample.Problems = append(sample.Problems, fmt.Sprintf("size of %d bytes exceeds the limit of %d bytes", size, limits.MaxFileSize))
+		} else {
+			sample.Problems = append(sample.Problems, fmt.Sprintf("size exceeds the limit of %d bytes", limits.MaxFileSize))
+		}
+		if sample.Format == "" {
+			sample.Problems = append(sample.Problems, "unsupported format")
+		}
+		return sample
+	}
+
+	var err error
+	switch sample.Format {
+	case SampleFormatMP3:
+		sample.DurationSecs, err = mp3Duration(content)
+	case SampleFormatWAV:
+		sample.DurationSecs, sample.SilenceRatio, err = analyzeWAV(content)
+	case "":
+		sample.Problems = append(sample.Problems, "unsupported format")
+	}
+	if err != nil {
+		sample.Problems = append(sample.Problems, err.Error())
+	}
+	if sample.SilenceRatio != nil && *sample.SilenceRatio > limits.MaxSilenceRatio {
+		sample.Problems = append(sample.Problems, fmt.Sprintf("%.0f%% of the sample is silent, more than the limit of %.0f%%", *sample.SilenceRatio*100, limits.MaxSilenceRatio*100))
+	}
+	return sample
+}
+
+// sniffSampleFormat recognizes the supported audio formats from the first bytes of their content.
+func sniffSampleFormat(content []byte) string {
+	switch {
+	case len(content) >= 12 && string(content[:4]) == "RIFF" && string(content[8:12]) == "WAVE":
+		return SampleFormatWAV
+	case len(content) >= 4 && string(content[:4]) == "fLaC":
+		return SampleFormatFLAC
+	case len(content) >= 4 && string(content[:4]) == "OggS":
+		return SampleFormatOGG
+	case len(content) >= 8 && string(content[4:8]) == "ftyp":
+		return SampleFormatM4A
+	case len(content) >= 3 && string(content[:3]) == "ID3":
+		return SampleFormatMP3
+	case len(content) >= 4 && mp3FrameHeader(content).valid():
+		return SampleFormatMP3
+	}
+	return ""
+}
+
+var (
+	mp3Bitrates = map[[2]int][15]int{ // In kbps, keyed by MPEG version 1 or 2 (including 2.5) and layer
+		{1, 1}: {0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{1, 2}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{1, 3}: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		{2, 1}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{2, 2}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{2, 3}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+	mp3SampleRates = map[int][3]int{ // Keyed by the version bits of the frame header
+		3: {44100, 48000, 32000}, // MPEG 1
+		2: {22050, 24000, 16000}, // MPEG 2
+		0: {11025, 12000, 8000},  // MPEG 2.5
+	}
+)
+
+type mp3FrameHeader []byte
+
+func (h mp3FrameHeader) valid() bool {
+	_, _, ok := h.parse()
+	return ok
+}
+
+// parse returns the length in bytes and the duration in seconds of the frame starting with h.
+func (h mp3FrameHeader) parse() (length int, duration float64, ok bool) {
+	if len(h) < 4 || h[0] != 0xff || h[1]&0xe0 != 0xe0 {
+		return 0, 0, false
+	}
+	versionBits, layerBits := int(h[1]>>3&3), int(h[1]>>1&3)
+	bitrateIndex, sampleRateIndex, padding := int(h[2]>>4), int(h[2]>>2&3), int(h[2]>>1&1)
+	if versionBits == 1 || layerBits == 0 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return 0, 0, false
+	}
+
+	version, layer := 2, 4-layerBits
+	if versionBits == 3 {
+		version = 1
+	}
+	bitrate := mp3Bitrates[[2]int{version, layer}][bitrateIndex] * 1000
+	sampleRate := mp3SampleRates[versionBits][sampleRateIndex]
+
+	samples := 1152
+	switch {
+	case layer == 1:
+		samples = 384
+		length = (12*bitrate/sampleRate + padding) * 4
+	case layer == 3 && version == 2:
+		samples = 576
+		length = 72*bitrate/sampleRate + padding
+	default:
+		length = 144*bitrate/sampleRate + padding
+	}
+	return length, float64(samples) / float64(sampleRate), true
+}
+
+// mp3Duration returns the duration of an MP3 file by walking its frames, which works for both constant and
+// variable bitrates.
+func mp3Duration(content []byte) (float64, error) {
+	pos := 0
+	if len(content) >= 10 && string(content[:3]) == "ID3" {
+		// The size of ID3v2 tags is a syncsafe integer, excluding the header and the optional footer.
+		pos = 10 + (int(content[6])<<21 | int(content[7])<<14 | int(content[8])<<7 | int(content[9]))
+		if content[5]&0x10 != 0 {
+			pos += 10
+		}
+	}
+	// Skip any padding before the first frame.
+	for pos < len(content) && !mp3FrameHeader(content[pos:]).valid() {
+		pos++
+	}
+
+	var duration float64
+	frames := 0
+	for pos < len(content) {
+		length, frameDuration, ok := mp3FrameHeader(content[pos:]).parse()
+		if !ok || length <= 0 {
+			break
+		}
+		duration += frameDuration
+		frames++
+		pos += length
+	}
+	if frames == 0 {
+		return 0, fmt.Errorf("no MP3 frames found")
+	}
+	return duration, nil
+}
+
+// analyzeWAV returns the duration of a WAV file and, for uncompressed audio, the share of silent windows.
+func analyzeWAV(content []byte) (float64, *float64, error) {
+	var format, channels, bitsPerSample int
+	var sampleRate, byteRate int
+	var data []byte
+	for pos := 12; pos+8 <= len(content); {
+		id, size := string(content[pos:pos+4]), int(binary.LittleEndian.Uint32(content[pos+4:pos+8]))
+		pos += 8
+		end := pos + size
+		if end > len(content) || end < pos {
+			end = len(content) // Truncated or streamed files may declare a wrong size.
+		}
+		chunk := content[pos:end]
+		switch id {
+		case "fmt ":
+			if len(chunk) < 16 {
+				return 0, nil, fmt.Errorf("invalid WAV format chunk")
+			}
+			format = int(binary.LittleEndian.Uint16(chunk[0:2]))
+			channels = int(binary.LittleEndian.Uint16(chunk[2:4]))
+			sampleRate = int(binary.LittleEndian.Uint32(chunk[4:8]))
+			byteRate = int(binary.LittleEndian.Uint32(chunk[8:12]))
+			bitsPerSample = int(binary.LittleEndian.Uint16(chunk[14:16]))
+			if format == 0xfffe && len(chunk) >= 26 { // WAVE_FORMAT_EXTENSIBLE, whose sub-format starts with the format code
+				format = int(binary.LittleEndian.Uint16(chunk[24:26]))
+			}
+		case "data":
+			data = chunk
+		}
+		pos = end + size%2 // Chunks are padded to an even size.
+	}
+	if byteRate == 0 || channels == 0 {
+		return 0, nil, fmt.Errorf("missing WAV format chunk")
+	}
+	if data == nil {
+		return 0, nil, fmt.Errorf("missing WAV data chunk")
+	}
+
+	duration := float64(len(data)) / float64(byteRate)
+	sample := pcmSampleDecoder(format, bitsPerSample)
+	if sample == nil {
+		return duration, nil, nil
+	}
+	bytesPerFrame := channels * bitsPerSample / 8
+	windowBytes := sampleRate / sampleSilenceWindowsPerSec * bytesPerFrame
+	if windowBytes == 0 {
+		return duration, nil, nil
+	}
+
+	windows, silent := 0, 0
+	for start := 0; start+windowBytes <= len(data); start += windowBytes {
+		var sum float64
+		for i := start; i < start+windowBytes; i += bitsPerSample / 8 {
+			v := sample(data[i:])
+			sum += v * v
+		}
+		if math.Sqrt(sum/float64(windowBytes/(bitsPerSample/8))) < sampleSilenceThreshold {
+			silent++
+		}
+		windows++
+	}
+	if windows == 0 {
+		return duration, nil, nil
+	}
+	ratio := float64(silent) / float64(windows)
+	return duration, &ratio, nil
+}
+
+// pcmSampleDecoder returns a function decoding a little-endian sample to [-1, 1], or nil if the format isn't
+// uncompressed audio.
+func pcmSampleDecoder(format, bitsPerSample int) func(b []byte) float64 {
+	switch {
+	case format == 1 && bitsPerSample == 8:
+		return func(b []byte) float64 { return (float64(b[0]) - 128) / 128 }
+	case format == 1 && bitsPerSample == 16:
+		return func(b []byte) float64 { return float64(int16(binary.LittleEndian.Uint16(b))) / (1 << 15) }
+	case format == 1 && bitsPerSample == 24:
+		return func(b []byte) float64 {
+			return float64(int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24)>>8) / (1 << 23)
+		}
+	case format == 1 && bitsPerSample == 32:
+		return func(b []byte) float64 { return float64(int32(binary.LittleEndian.Uint32(b))) / (1 << 31) }
+	case format == 3 && bitsPerSample == 32:
+		return func(b []byte) float64 { return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))) }
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+// testWAV returns a mono 16-bit PCM WAV file at 8 kHz with toneSecs of tone followed by silenceSecs of silence.
+func testWAV(toneSecs, silenceSecs int) []byte {
+	const sampleRate = 8000
+	var data bytes.Buffer
+	for i := 0; i < sampleRate*toneSecs; i++ {
+		binary.Write(&data, binary.LittleEndian, int16(8000*math.Sin(2*math.Pi*440*float64(i)/sampleRate)))
+	}
+	data.Write(make([]byte, 2*sampleRate*silenceSecs))
+
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+data.Len()))
+	b.WriteString("WAVEfmt ")
+	for _, v := range []interface{}{uint32(16), uint16(1), uint16(1), uint32(sampleRate), uint32(2 * sampleRate), uint16(2), uint16(16)} {
+		binary.Write(&b, binary.LittleEndian, v)
+	}
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(data.Len()))
+	b.Write(data.Bytes())
+	return b.Bytes()
+}
+
+// testMP3 returns an MP3 file of frames MPEG 1 layer III frames at 128 kbps and 44.1 kHz, after an ID3v2 tag.
+func testMP3(frames int) []byte {
+	b := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 10}
+	b = append(b, make([]byte, 10)...)
+	for i := 0; i < frames; i++ {
+		frame := make([]byte, 417)
+		copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+		b = append(b, frame...)
+	}
+	return append(b, "TAG"...)
+}
+
+func TestValidateVoiceSamples(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "speech.mp3")
+	if err := os.WriteFile(path, testMP3(2000), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	wav := testWAV(10, 2)
+	req := elevenlabs.AddEditVoiceRequest{
+		Name:      "Voice",
+		FilePaths: []string{path},
+		Files:     []elevenlabs.SampleFile{{FileName: "speech.wav", Content: io.MultiReader(bytes.NewReader(wav))}},
+	}
+
+	report, err := req.Validate(elevenlabs.SampleValidationLimits{})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if !report.Valid() {
+		t.Errorf("Expected valid samples, got %+v", report)
+	}
+	mp3, pcm := report.Samples[0], report.Samples[1]
+	if mp3.FileName != "speech.mp3" || mp3.Format != elevenlabs.SampleFormatMP3 || math.Abs(mp3.DurationSecs-2000*1152/44100.0) > 1e-6 || mp3.SilenceRatio != nil {
+		t.Errorf("Unexpected MP3 report %+v", mp3)
+	}
+	if pcm.Format != elevenlabs.SampleFormatWAV || pcm.DurationSecs != 12 || pcm.SilenceRatio == nil || math.Abs(*pcm.SilenceRatio-2/12.0) > 1e-6 {
+		t.Errorf("Unexpected WAV report %+v", pcm)
+	}
+	if report.TotalSizeBytes != mp3.SizeBytes+int64(len(wav)) {
+		t.Errorf("Unexpected total size %d", report.TotalSizeBytes)
+	}
+
+	// The content consumed by validation is replaced so that the sample can still be uploaded.
+	if content, _ := io.ReadAll(req.Files[0].Content); !bytes.Equal(content, wav) {
+		t.Errorf("Expected sample content to be preserved, got %d bytes", len(content))
+	}
+}
+
+func TestValidateVoiceSamplesProblems(t *testing.T) {
+	req := elevenlabs.AddEditVoiceRequest{
+		Name: "Voice",
+		Files: []elevenlabs.SampleFile{
+			{FileName: "quiet.wav", Content: bytes.NewReader(testWAV(1, 3))},
+			{FileName: "notes.txt", Content: strings.NewReader("not audio")},
+			{FileName: "flac.flac", Content: strings.NewReader("fLaC\x00\x00\x00\x22")},
+		},
+	}
+
+	report, err := req.Validate(elevenlabs.SampleValidationLimits{MaxFiles: 2})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if report.Valid() {
+		t.Fatalf("Expected invalid samples, got %+v", report)
+	}
+	if len(report.Samples[0].Problems) != 1 || !strings.Contains(report.Samples[0].Problems[0], "silent") {
+		t.Errorf("Expected silence problem, got %q", report.Samples[0].Problems)
+	}
+	if report.Samples[1].Format != "" || len(report.Samples[1].Problems) != 1 {
+		t.Errorf("Expected unsupported format, got %+v", report.Samples[1])
+	}
+	if report.Samples[2].Format != elevenlabs.SampleFormatFLAC || len(report.Samples[2].Problems) != 0 {
+		t.Errorf("Expected valid FLAC sample, got %+v", report.Samples[2])
+	}
+	// The total duration isn't checked as that of the FLAC sample is unknown.
+	if len(report.Problems) != 1 || !strings.Contains(report.Problems[0], "3 samples") {
+		t.Errorf("Expected too many samples, got %q", report.Problems)
+	}
+}
+
+func TestValidateVoiceSamplesStreams(t *testing.T) {
+	wav := testWAV(2, 0)
+	prefixed := bytes.NewReader(append([]byte("header"), wav...))
+	prefixed.Seek(6, io.SeekStart)
+	large := bytes.Repeat([]byte("ID3"), 15000)
+	req := elevenlabs.AddEditVoiceRequest{
+		Name: "Voice",
+		Files: []elevenlabs.SampleFile{
+			{FileName: "prefixed.wav", Content: prefixed},
+			{FileName: "large.mp3", Content: bytes.NewReader(large)},
+			{FileName: "stream.mp3", Content: io.MultiReader(bytes.NewReader(large))},
+		},
+	}
+
+	report, err := req.Validate(elevenlabs.SampleValidationLimits{MaxFileSize: 40000})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if report.Samples[0].Format != elevenlabs.SampleFormatWAV || report.Samples[0].DurationSecs != 2 || len(report.Samples[0].Problems) != 0 {
+		t.Errorf("Expected sample read from its position, got %+v", report.Samples[0])
+	}
+	if report.Samples[1].SizeBytes != 45000 || len(report.Samples[1].Problems) != 1 || !strings.Contains(report.Samples[1].Problems[0], "45000 bytes") {
+		t.Errorf("Expected oversized sample of 45000 bytes, got %+v", report.Samples[1])
+	}
+	if report.Samples[2].SizeBytes != 40001 || len(report.Samples[2].Problems) != 1 || !strings.Contains(report.Samples[2].Problems[0], "exceeds") {
+		t.Errorf("Expected oversized stream read up to the limit, got %+v", report.Samples[2])
+	}
+
+	// Every sample can still be uploaded from where it was.
+	for i, exp := range [][]byte{wav, large, large} {
+		if content, _ := io.ReadAll(req.Files[i].Content); !bytes.Equal(content, exp) {
+			t.Errorf("Expected content of sample %d to be preserved, got %d bytes", i, len(content))
+		}
+	}
+}
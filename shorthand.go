@@ -3,7 +3,12 @@
 
 package elevenlabs
 
-import "io"
+import (
+	"context"
+	"io"
+	"io/fs"
+	"time"
+)
 
 // SetBaseURL calls the SetBaseURL method on the default client.
 func SetBaseURL(baseURL string) {
@@ -30,6 +35,11 @@ func GetVoices() ([]Voice, error) {
 	return getDefaultClient().GetVoices()
 }
 
+// SearchVoices calls the SearchVoices method on the default client.
+func SearchVoices(filter VoiceSearchFilter, queries ...QueryFunc) (SearchVoicesResponse, NextVoicesPageFunc, error) {
+	return getDefaultClient().SearchVoices(filter, queries...)
+}
+
 // GetDefaultVoiceSettings calls the GetDefaultVoiceSettings method on the default client.
 func GetDefaultVoiceSettings() (VoiceSettings, error) {
 	return getDefaultClient().GetDefaultVoiceSettings()
@@ -114,3 +124,458 @@ func GetUser() (User, error) {
 func SpeechToText(req SpeechToTextRequest, opts ...RequestOption) (interface{}, error) {
 	return getDefaultClient().SpeechToText(req, opts...)
 }
+
+// ForcedAlignment calls the ForcedAlignment method on the default client.
+func ForcedAlignment(req ForcedAlignmentRequest, opts ...RequestOption) (ForcedAlignmentResponse, error) {
+	return getDefaultClient().ForcedAlignment(req, opts...)
+}
+
+// GenerateSoundEffect calls the GenerateSoundEffect method on the default client.
+func GenerateSoundEffect(sfxReq SoundEffectRequest, opts ...RequestOption) ([]byte, ResponseMetadata, error) {
+	return getDefaultClient().GenerateSoundEffect(sfxReq, opts...)
+}
+
+// GenerateSoundEffectStream calls the GenerateSoundEffectStream method on the default client.
+func GenerateSoundEffectStream(streamWriter io.Writer, sfxReq SoundEffectRequest, opts ...RequestOption) (ResponseMetadata, error) {
+	return getDefaultClient().GenerateSoundEffectStream(streamWriter, sfxReq, opts...)
+}
+
+// SpeechToSpeech calls the SpeechToSpeech method on the default client.
+func SpeechToSpeech(voiceID string, stsReq SpeechToSpeechRequest, opts ...RequestOption) ([]byte, error) {
+	return getDefaultClient().SpeechToSpeech(voiceID, stsReq, opts...)
+}
+
+// SpeechToSpeechStream calls the SpeechToSpeechStream method on the default client.
+func SpeechToSpeechStream(streamWriter io.Writer, voiceID string, stsReq SpeechToSpeechRequest, opts ...RequestOption) error {
+	return getDefaultClient().SpeechToSpeechStream(streamWriter, voiceID, stsReq, opts...)
+}
+
+// AudioIsolation calls the AudioIsolation method on the default client.
+func AudioIsolation(isoReq AudioIsolationRequest, opts ...RequestOption) ([]byte, error) {
+	return getDefaultClient().AudioIsolation(isoReq, opts...)
+}
+
+// AudioIsolationStream calls the AudioIsolationStream method on the default client.
+func AudioIsolationStream(streamWriter io.Writer, isoReq AudioIsolationRequest, opts ...RequestOption) error {
+	return getDefaultClient().AudioIsolationStream(streamWriter, isoReq, opts...)
+}
+
+// AddVoiceWithIsolation calls the AddVoiceWithIsolation method on the default client.
+func AddVoiceWithIsolation(voiceReq AddEditVoiceRequest, opts ...RequestOption) (string, error) {
+	return getDefaultClient().AddVoiceWithIsolation(voiceReq, opts...)
+}
+
+// DesignVoice calls the DesignVoice method on the default client.
+func DesignVoice(designReq VoiceDesignRequest, opts ...RequestOption) (VoiceDesignResponse, error) {
+	return getDefaultClient().DesignVoice(designReq, opts...)
+}
+
+// CreateVoiceFromPreview calls the CreateVoiceFromPreview method on the default client.
+func CreateVoiceFromPreview(voiceReq CreateVoiceFromPreviewRequest, opts ...RequestOption) (Voice, error) {
+	return getDefaultClient().CreateVoiceFromPreview(voiceReq, opts...)
+}
+
+// GetSharedVoices calls the GetSharedVoices method on the default client.
+func GetSharedVoices(filter SharedVoicesFilter, queries ...QueryFunc) (GetSharedVoicesResponse, NextSharedVoicesPageFunc, error) {
+	return getDefaultClient().GetSharedVoices(filter, queries...)
+}
+
+// AddSharedVoice calls the AddSharedVoice method on the default client.
+func AddSharedVoice(publicOwnerID, voiceID, newName string, opts ...RequestOption) (string, error) {
+	return getDefaultClient().AddSharedVoice(publicOwnerID, voiceID, newName, opts...)
+}
+
+// CreatePVCVoice calls the CreatePVCVoice method on the default client.
+func CreatePVCVoice(voiceReq CreatePVCVoiceRequest, opts ...RequestOption) (string, error) {
+	return getDefaultClient().CreatePVCVoice(voiceReq, opts...)
+}
+
+// AddPVCSamples calls the AddPVCSamples method on the default client.
+func AddPVCSamples(voiceID string, samplesReq AddPVCSamplesRequest, opts ...RequestOption) ([]PVCSample, error) {
+	return getDefaultClient().AddPVCSamples(voiceID, samplesReq, opts...)
+}
+
+// UpdatePVCSample calls the UpdatePVCSample method on the default client.
+func UpdatePVCSample(voiceID, sampleID string, sampleReq UpdatePVCSampleRequest, opts ...RequestOption) error {
+	return getDefaultClient().UpdatePVCSample(voiceID, sampleID, sampleReq, opts...)
+}
+
+// StartSpeakerSeparation calls the StartSpeakerSeparation method on the default client.
+func StartSpeakerSeparation(voiceID, sampleID string, opts ...RequestOption) error {
+	return getDefaultClient().StartSpeakerSeparation(voiceID, sampleID, opts...)
+}
+
+// GetSpeakerSeparation calls the GetSpeakerSeparation method on the default client.
+func GetSpeakerSeparation(voiceID, sampleID string, opts ...RequestOption) (SpeakerSeparation, error) {
+	return getDefaultClient().GetSpeakerSeparation(voiceID, sampleID, opts...)
+}
+
+// GetPVCCaptcha calls the GetPVCCaptcha method on the default client.
+func GetPVCCaptcha(voiceID string, opts ...RequestOption) ([]byte, error) {
+	return getDefaultClient().GetPVCCaptcha(voiceID, opts...)
+}
+
+// VerifyPVCCaptcha calls the VerifyPVCCaptcha method on the default client.
+func VerifyPVCCaptcha(voiceID string, recording io.Reader, fileName string, opts ...RequestOption) error {
+	return getDefaultClient().VerifyPVCCaptcha(voiceID, recording, fileName, opts...)
+}
+
+// RequestPVCVerification calls the RequestPVCVerification method on the default client.
+func RequestPVCVerification(voiceID string, verificationReq PVCVerificationRequest, opts ...RequestOption) error {
+	return getDefaultClient().RequestPVCVerification(voiceID, verificationReq, opts...)
+}
+
+// TrainPVCVoice calls the TrainPVCVoice method on the default client.
+func TrainPVCVoice(voiceID, modelID string, opts ...RequestOption) error {
+	return getDefaultClient().TrainPVCVoice(voiceID, modelID, opts...)
+}
+
+// CreateDubbing calls the CreateDubbing method on the default client.
+func CreateDubbing(dubReq DubbingRequest, opts ...RequestOption) (*DubbingJob, error) {
+	return getDefaultClient().CreateDubbing(dubReq, opts...)
+}
+
+// GetDubbing calls the GetDubbing method on the default client.
+func GetDubbing(dubbingID string, opts ...RequestOption) (DubbingMetadata, error) {
+	return getDefaultClient().GetDubbing(dubbingID, opts...)
+}
+
+// GetDubbedAudio calls the GetDubbedAudio method on the default client.
+func GetDubbedAudio(streamWriter io.Writer, dubbingID, languageCode string, opts ...RequestOption) error {
+	return getDefaultClient().GetDubbedAudio(streamWriter, dubbingID, languageCode, opts...)
+}
+
+// GetDubbingTranscript calls the GetDubbingTranscript method on the default client.
+func GetDubbingTranscript(dubbingID, languageCode string, opts ...RequestOption) ([]byte, error) {
+	return getDefaultClient().GetDubbingTranscript(dubbingID, languageCode, opts...)
+}
+
+// GetDubbingResource calls the GetDubbingResource method on the default client.
+func GetDubbingResource(dubbingID string, opts ...RequestOption) (DubbingResource, error) {
+	return getDefaultClient().GetDubbingResource(dubbingID, opts...)
+}
+
+// EditDubbingSegment calls the EditDubbingSegment method on the default client.
+func EditDubbingSegment(dubbingID, segmentID, languageCode string, update DubbingSegmentUpdate, opts ...RequestOption) error {
+	return getDefaultClient().EditDubbingSegment(dubbingID, segmentID, languageCode, update, opts...)
+}
+
+// DeleteDubbing calls the DeleteDubbing method on the default client.
+func DeleteDubbing(dubbingID string, opts ...RequestOption) error {
+	return getDefaultClient().DeleteDubbing(dubbingID, opts...)
+}
+
+// CreateProject calls the CreateProject method on the default client.
+func CreateProject(projReq CreateProjectRequest, opts ...RequestOption) (Project, error) {
+	return getDefaultClient().CreateProject(projReq, opts...)
+}
+
+// GetProjects calls the GetProjects method on the default client.
+func GetProjects(queries ...QueryFunc) (GetProjectsResponse, NextProjectsPageFunc, error) {
+	return getDefaultClient().GetProjects(queries...)
+}
+
+// GetProject calls the GetProject method on the default client.
+func GetProject(projectID string, opts ...RequestOption) (Project, error) {
+	return getDefaultClient().GetProject(projectID, opts...)
+}
+
+// DeleteProject calls the DeleteProject method on the default client.
+func DeleteProject(projectID string, opts ...RequestOption) error {
+	return getDefaultClient().DeleteProject(projectID, opts...)
+}
+
+// ConvertProject calls the ConvertProject method on the default client.
+func ConvertProject(projectID string, opts ...RequestOption) error {
+	return getDefaultClient().ConvertProject(projectID, opts...)
+}
+
+// GetChapters calls the GetChapters method on the default client.
+func GetChapters(projectID string, queries ...QueryFunc) (GetChaptersResponse, NextChaptersPageFunc, error) {
+	return getDefaultClient().GetChapters(projectID, queries...)
+}
+
+// GetChapter calls the GetChapter method on the default client.
+func GetChapter(projectID, chapterID string, opts ...RequestOption) (Chapter, error) {
+	return getDefaultClient().GetChapter(projectID, chapterID, opts...)
+}
+
+// EditChapter calls the EditChapter method on the default client.
+func EditChapter(projectID, chapterID string, chapReq EditChapterRequest, opts ...RequestOption) error {
+	return getDefaultClient().EditChapter(projectID, chapterID, chapReq, opts...)
+}
+
+// ConvertChapter calls the ConvertChapter method on the default client.
+func ConvertChapter(projectID, chapterID string, opts ...RequestOption) error {
+	return getDefaultClient().ConvertChapter(projectID, chapterID, opts...)
+}
+
+// GetProjectSnapshots calls the GetProjectSnapshots method on the default client.
+func GetProjectSnapshots(projectID string, opts ...RequestOption) ([]ProjectSnapshot, error) {
+	return getDefaultClient().GetProjectSnapshots(projectID, opts...)
+}
+
+// DownloadProjectSnapshot calls the DownloadProjectSnapshot method on the default client.
+func DownloadProjectSnapshot(w io.Writer, projectID, snapshotID string, opts ...RequestOption) error {
+	return getDefaultClient().DownloadProjectSnapshot(w, projectID, snapshotID, opts...)
+}
+
+// GetChapterSnapshots calls the GetChapterSnapshots method on the default client.
+func GetChapterSnapshots(projectID, chapterID string, opts ...RequestOption) ([]ChapterSnapshot, error) {
+	return getDefaultClient().GetChapterSnapshots(projectID, chapterID, opts...)
+}
+
+// DownloadChapterSnapshot calls the DownloadChapterSnapshot method on the default client.
+func DownloadChapterSnapshot(w io.Writer, projectID, chapterID, snapshotID string, opts ...RequestOption) error {
+	return getDefaultClient().DownloadChapterSnapshot(w, projectID, chapterID, snapshotID, opts...)
+}
+
+// AddPronunciationDictionary calls the AddPronunciationDictionary method on the default client.
+func AddPronunciationDictionary(dictReq AddPronunciationDictionaryRequest, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	return getDefaultClient().AddPronunciationDictionary(dictReq, opts...)
+}
+
+// AddPronunciationDictionaryFromRules calls the AddPronunciationDictionaryFromRules method on the default client.
+func AddPronunciationDictionaryFromRules(dictReq AddPronunciationDictionaryFromRulesRequest, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	return getDefaultClient().AddPronunciationDictionaryFromRules(dictReq, opts...)
+}
+
+// AddPronunciationRules calls the AddPronunciationRules method on the default client.
+func AddPronunciationRules(dictionaryID string, rules []PronunciationRule, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	return getDefaultClient().AddPronunciationRules(dictionaryID, rules, opts...)
+}
+
+// RemovePronunciationRules calls the RemovePronunciationRules method on the default client.
+func RemovePronunciationRules(dictionaryID string, ruleStrings []string, opts ...RequestOption) (PronunciationDictionaryVersion, error) {
+	return getDefaultClient().RemovePronunciationRules(dictionaryID, ruleStrings, opts...)
+}
+
+// GetPronunciationDictionary calls the GetPronunciationDictionary method on the default client.
+func GetPronunciationDictionary(dictionaryID string, opts ...RequestOption) (PronunciationDictionary, error) {
+	return getDefaultClient().GetPronunciationDictionary(dictionaryID, opts...)
+}
+
+// UpdatePronunciationDictionary calls the UpdatePronunciationDictionary method on the default client.
+func UpdatePronunciationDictionary(dictionaryID string, dictReq UpdatePronunciationDictionaryRequest, opts ...RequestOption) (PronunciationDictionary, error) {
+	return getDefaultClient().UpdatePronunciationDictionary(dictionaryID, dictReq, opts...)
+}
+
+// ArchivePronunciationDictionary calls the ArchivePronunciationDictionary method on the default client.
+func ArchivePronunciationDictionary(dictionaryID string, opts ...RequestOption) (PronunciationDictionary, error) {
+	return getDefaultClient().ArchivePronunciationDictionary(dictionaryID, opts...)
+}
+
+// GetPronunciationDictionaryVersions calls the GetPronunciationDictionaryVersions method on the default client.
+func GetPronunciationDictionaryVersions(dictionaryID string, opts ...RequestOption) ([]PronunciationDictionaryVersion, error) {
+	return getDefaultClient().GetPronunciationDictionaryVersions(dictionaryID, opts...)
+}
+
+// GetPronunciationDictionaries calls the GetPronunciationDictionaries method on the default client.
+func GetPronunciationDictionaries(queries ...QueryFunc) (GetPronunciationDictionariesResponse, NextPronunciationDictionariesPageFunc, error) {
+	return getDefaultClient().GetPronunciationDictionaries(queries...)
+}
+
+// DownloadPronunciationDictionaryVersion calls the DownloadPronunciationDictionaryVersion method on the default client.
+func DownloadPronunciationDictionaryVersion(w io.Writer, dictionaryID, versionID string, opts ...RequestOption) error {
+	return getDefaultClient().DownloadPronunciationDictionaryVersion(w, dictionaryID, versionID, opts...)
+}
+
+// TextToDialogue calls the TextToDialogue method on the default client.
+func TextToDialogue(dialogueReq TextToDialogueRequest, opts ...RequestOption) ([]byte, error) {
+	return getDefaultClient().TextToDialogue(dialogueReq, opts...)
+}
+
+// TextToDialogueStream calls the TextToDialogueStream method on the default client.
+func TextToDialogueStream(streamWriter io.Writer, dialogueReq TextToDialogueRequest, opts ...RequestOption) error {
+	return getDefaultClient().TextToDialogueStream(streamWriter, dialogueReq, opts...)
+}
+
+// TextToDialogueWithTimestamps calls the TextToDialogueWithTimestamps method on the default client.
+func TextToDialogueWithTimestamps(dialogueReq TextToDialogueRequest, opts ...RequestOption) (TextToDialogueWithTimestampsResponse, error) {
+	return getDefaultClient().TextToDialogueWithTimestamps(dialogueReq, opts...)
+}
+
+// CreateAgent calls the CreateAgent method on the default client.
+func CreateAgent(config AgentConfig, opts ...RequestOption) (string, error) {
+	return getDefaultClient().CreateAgent(config, opts...)
+}
+
+// GetAgent calls the GetAgent method on the default client.
+func GetAgent(agentID string, opts ...RequestOption) (Agent, error) {
+	return getDefaultClient().GetAgent(agentID, opts...)
+}
+
+// UpdateAgent calls the UpdateAgent method on the default client.
+func UpdateAgent(agentID string, config AgentConfig, opts ...RequestOption) (Agent, error) {
+	return getDefaultClient().UpdateAgent(agentID, config, opts...)
+}
+
+// DeleteAgent calls the DeleteAgent method on the default client.
+func DeleteAgent(agentID string, opts ...RequestOption) error {
+	return getDefaultClient().DeleteAgent(agentID, opts...)
+}
+
+// DuplicateAgent calls the DuplicateAgent method on the default client.
+func DuplicateAgent(agentID, name string, opts ...RequestOption) (string, error) {
+	return getDefaultClient().DuplicateAgent(agentID, name, opts...)
+}
+
+// GetAgents calls the GetAgents method on the default client.
+func GetAgents(queries ...QueryFunc) (GetAgentsResponse, NextAgentsPageFunc, error) {
+	return getDefaultClient().GetAgents(queries...)
+}
+
+// GetSignedURL calls the GetSignedURL method on the default client.
+func GetSignedURL(agentID string, opts ...RequestOption) (string, error) {
+	return getDefaultClient().GetSignedURL(agentID, opts...)
+}
+
+// GetConversationToken calls the GetConversationToken method on the default client.
+func GetConversationToken(agentID string, opts ...RequestOption) (string, error) {
+	return getDefaultClient().GetConversationToken(agentID, opts...)
+}
+
+// GetConversations calls the GetConversations method on the default client.
+func GetConversations(queries ...QueryFunc) (GetConversationsResponse, NextConversationsPageFunc, error) {
+	return getDefaultClient().GetConversations(queries...)
+}
+
+// GetConversation calls the GetConversation method on the default client.
+func GetConversation(conversationID string, opts ...RequestOption) (ConversationDetails, error) {
+	return getDefaultClient().GetConversation(conversationID, opts...)
+}
+
+// GetConversationAudio calls the GetConversationAudio method on the default client.
+func GetConversationAudio(w io.Writer, conversationID string, opts ...RequestOption) error {
+	return getDefaultClient().GetConversationAudio(w, conversationID, opts...)
+}
+
+// SendConversationFeedback calls the SendConversationFeedback method on the default client.
+func SendConversationFeedback(conversationID, feedback string, opts ...RequestOption) error {
+	return getDefaultClient().SendConversationFeedback(conversationID, feedback, opts...)
+}
+
+// DeleteConversation calls the DeleteConversation method on the default client.
+func DeleteConversation(conversationID string, opts ...RequestOption) error {
+	return getDefaultClient().DeleteConversation(conversationID, opts...)
+}
+
+// AddKnowledgeBaseFile calls the AddKnowledgeBaseFile method on the default client.
+func AddKnowledgeBaseFile(docReq AddKnowledgeBaseFileRequest, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	return getDefaultClient().AddKnowledgeBaseFile(docReq, opts...)
+}
+
+// AddKnowledgeBaseURL calls the AddKnowledgeBaseURL method on the default client.
+func AddKnowledgeBaseURL(docURL, name string, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	return getDefaultClient().AddKnowledgeBaseURL(docURL, name, opts...)
+}
+
+// AddKnowledgeBaseText calls the AddKnowledgeBaseText method on the default client.
+func AddKnowledgeBaseText(text, name string, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	return getDefaultClient().AddKnowledgeBaseText(text, name, opts...)
+}
+
+// GetKnowledgeBaseDocuments calls the GetKnowledgeBaseDocuments method on the default client.
+func GetKnowledgeBaseDocuments(queries ...QueryFunc) (GetKnowledgeBaseDocumentsResponse, NextKnowledgeBaseDocumentsPageFunc, error) {
+	return getDefaultClient().GetKnowledgeBaseDocuments(queries...)
+}
+
+// GetKnowledgeBaseDocument calls the GetKnowledgeBaseDocument method on the default client.
+func GetKnowledgeBaseDocument(documentID string, opts ...RequestOption) (KnowledgeBaseDocument, error) {
+	return getDefaultClient().GetKnowledgeBaseDocument(documentID, opts...)
+}
+
+// DeleteKnowledgeBaseDocument calls the DeleteKnowledgeBaseDocument method on the default client.
+func DeleteKnowledgeBaseDocument(documentID string, opts ...RequestOption) error {
+	return getDefaultClient().DeleteKnowledgeBaseDocument(documentID, opts...)
+}
+
+// ComputeRAGIndex calls the ComputeRAGIndex method on the default client.
+func ComputeRAGIndex(documentID, model string, opts ...RequestOption) (RAGIndex, error) {
+	return getDefaultClient().ComputeRAGIndex(documentID, model, opts...)
+}
+
+// NewConversation calls the NewConversation method on the default client.
+func NewConversation(config ConversationSessionConfig) *Conversation {
+	return getDefaultClient().NewConversation(config)
+}
+
+// IterateConversations calls the IterateConversations method on the default client.
+func IterateConversations(ctx context.Context, filter ConversationFilter) *ConversationIterator {
+	return getDefaultClient().IterateConversations(ctx, filter)
+}
+
+// ScreenplayToDialogue calls the ScreenplayToDialogue method on the default client.
+func ScreenplayToDialogue(script string, speakerVoices map[string]string) ([]DialogueInput, error) {
+	return getDefaultClient().ScreenplayToDialogue(script, speakerVoices)
+}
+
+// DubbingJobByID calls the DubbingJobByID method on the default client.
+func DubbingJobByID(dubbingID string) *DubbingJob {
+	return getDefaultClient().DubbingJobByID(dubbingID)
+}
+
+// WaitForRAGIndex calls the WaitForRAGIndex method on the default client.
+func WaitForRAGIndex(ctx context.Context, documentID, model string, pollInterval time.Duration) (RAGIndex, error) {
+	return getDefaultClient().WaitForRAGIndex(ctx, documentID, model, pollInterval)
+}
+
+// SyncKnowledgeBase calls the SyncKnowledgeBase method on the default client.
+func SyncKnowledgeBase(ctx context.Context, fsys fs.FS, options KnowledgeBaseSyncOptions) ([]KnowledgeBaseSyncAction, error) {
+	return getDefaultClient().SyncKnowledgeBase(ctx, fsys, options)
+}
+
+// NewPVCWorkflow calls the NewPVCWorkflow method on the default client.
+func NewPVCWorkflow() *PVCWorkflow {
+	return getDefaultClient().NewPVCWorkflow()
+}
+
+// ResumePVCWorkflow calls the ResumePVCWorkflow method on the default client.
+func ResumePVCWorkflow(state PVCState) *PVCWorkflow {
+	return getDefaultClient().ResumePVCWorkflow(state)
+}
+
+// RealtimeSpeechToText calls the RealtimeSpeechToText method on the default client.
+func RealtimeSpeechToText(config RealtimeSpeechToTextConfig, opts ...RequestOption) (*RealtimeSpeechToTextSession, error) {
+	return getDefaultClient().RealtimeSpeechToText(config, opts...)
+}
+
+// NewConversationCredentialsHandler calls the NewConversationCredentialsHandler method on the default client.
+func NewConversationCredentialsHandler(config ConversationCredentialsHandlerConfig) *ConversationCredentialsHandler {
+	return getDefaultClient().NewConversationCredentialsHandler(config)
+}
+
+// WaitForProjectConversion calls the WaitForProjectConversion method on the default client.
+func WaitForProjectConversion(ctx context.Context, projectID string, pollInterval time.Duration) (Project, error) {
+	return getDefaultClient().WaitForProjectConversion(ctx, projectID, pollInterval)
+}
+
+// WaitForChapterConversion calls the WaitForChapterConversion method on the default client.
+func WaitForChapterConversion(ctx context.Context, projectID, chapterID string, pollInterval time.Duration) (Chapter, error) {
+	return getDefaultClient().WaitForChapterConversion(ctx, projectID, chapterID, pollInterval)
+}
+
+// SetParagraphVoices calls the SetParagraphVoices method on the default client.
+func SetParagraphVoices(projectID, chapterID string, blockVoices map[string]string, opts ...RequestOption) error {
+	return getDefaultClient().SetParagraphVoices(projectID, chapterID, blockVoices, opts...)
+}
+
+// NewTelephonyBridge calls the NewTelephonyBridge method on the default client.
+func NewTelephonyBridge(config TelephonyBridgeConfig) *TelephonyBridge {
+	return getDefaultClient().NewTelephonyBridge(config)
+}
+
+// SnapshotVoices calls the SnapshotVoices method on the default client.
+func SnapshotVoices() (VoiceCatalog, error) {
+	return getDefaultClient().SnapshotVoices()
+}
+
+// ApplyVoicePlan calls the ApplyVoicePlan method on the default client.
+func ApplyVoicePlan(plan VoicePlan, dryRun bool, log io.Writer) error {
+	return getDefaultClient().ApplyVoicePlan(plan, dryRun, log)
+}
+
+// IterateVoices calls the IterateVoices method on the default client.
+func IterateVoices(ctx context.Context, filter VoiceSearchFilter) *VoiceIterator {
+	return getDefaultClient().IterateVoices(ctx, filter)
+}
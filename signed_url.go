@@ -0,0 +1,123 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSignedURLValidity      = 15 * time.Minute
+	defaultSignedURLRefreshMargin = 2 * time.Minute
+)
+
+// ConversationCredentialsHandlerConfig represents the settings of a ConversationCredentialsHandler.
+type ConversationCredentialsHandlerConfig struct {
+	// AgentID is the ID of the agent the credentials are minted for.
+	AgentID string
+	// Authorize is called for every request and must return a non-nil error if the user isn't allowed to talk
+	// with the agent, in which case the request is rejected with 401 Unauthorized. It is required.
+	Authorize func(r *http.Request) error
+	// Token makes the handler mint WebRTC conversation tokens with GetConversationToken instead of WebSocket
+	// signed URLs with GetSignedURL.
+	Token bool
+	// Validity is how long minted credentials remain valid. It defaults to, and can't exceed, 15 minutes, after
+	// which the server rejects them.
+	Validity time.Duration
+	// RefreshMargin is how long before they expire cached credentials are replaced. It must be shorter than
+	// Validity, and defaults to 2 minutes or half of Validity, whichever is shorter.
+	RefreshMargin time.Duration
+}
+
+// ConversationCredentials represents the JSON body served by a ConversationCredentialsHandler. Only one of
+// SignedURL and Token is set, depending on ConversationCredentialsHandlerConfig.Token.
+type ConversationCredentials struct {
+	SignedURL string    `json:"signed_url,omitempty"`
+	Token     string    `json:"token,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ConversationCredentialsHandler is an http.Handler that serves signed URLs or conversation tokens for an agent
+// to authorized users, so that browser clients can talk with private agents without holding the API key.
+//
+// Credentials are cached and shared between users until they are about to expire, limiting the number of calls
+// made to the API.
+type ConversationCredentialsHandler struct {
+	client *Client
+	config ConversationCredentialsHandlerConfig
+
+	mu     sync.Mutex
+	cached ConversationCredentials
+}
+
+// NewConversationCredentialsHandler returns a ConversationCredentialsHandler that mints credentials with the
+// client.
+//
+// It takes a ConversationCredentialsHandlerConfig argument that contains the ID of the agent and the function
+// authorizing users.
+func (c *Client) NewConversationCredentialsHandler(config ConversationCredentialsHandlerConfig) *ConversationCredentialsHandler {
+	if config.Validity <= 0 || config.Validity > defaultSignedURLValidity {
+		config.Validity = defaultSignedURLValidity
+	}
+	if config.RefreshMargin <= 0 || config.RefreshMargin >= config.Validity {
+		// A margin as long as the validity would disable caching.
+		config.RefreshMargin = defaultSignedURLRefreshMargin
+		if config.RefreshMargin > config.Validity/2 {
+			config.RefreshMargin = config.Validity / 2
+		}
+	}
+	return &ConversationCredentialsHandler{client: c, config: config}
+}
+
+func (h *ConversationCredentialsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if h.config.Authorize == nil || h.config.Authorize(r) != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	creds, err := h.credentials(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(creds)
+}
+
+// credentials returns the cached credentials, minting new ones if they are missing or about to expire. The lock
+// is held while minting so that concurrent requests share a single API call.
+func (h *ConversationCredentialsHandler) credentials(r *http.Request) (ConversationCredentials, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	if now.Add(h.config.RefreshMargin).Before(h.cached.ExpiresAt) {
+		return h.cached, nil
+	}
+
+	var creds ConversationCredentials
+	var err error
+	ctx, cancel := context.WithTimeout(r.Context(), h.client.defaultTimeout)
+	defer cancel()
+	if h.config.Token {
+		creds.Token, err = h.client.GetConversationToken(h.config.AgentID, WithRequestContext(ctx))
+	} else {
+		creds.SignedURL, err = h.client.GetSignedURL(h.config.AgentID, WithRequestContext(ctx))
+	}
+	if err != nil {
+		return ConversationCredentials{}, err
+	}
+
+	creds.ExpiresAt = now.Add(h.config.Validity)
+	h.cached = creds
+	return creds, nil
+}
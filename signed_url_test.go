@@ -0,0 +1,118 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestGetSignedURLAndToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "agent_id=a1" {
+			t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+		}
+		switch r.URL.Path {
+		case "/convai/conversation/get-signed-url":
+			w.Write([]byte(`{"signed_url": "wss://example.com/convai?agent_id=a1&conversation_signature=sig"}`))
+		case "/convai/conversation/token":
+			w.Write([]byte(`{"token": "tok"}`))
+		default:
+			t.Errorf("Server: unexpected path %q", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	signedURL, err := client.GetSignedURL("a1")
+	if err != nil || signedURL != "wss://example.com/convai?agent_id=a1&conversation_signature=sig" {
+		t.Errorf("Unexpected signed URL %q, %v", signedURL, err)
+	}
+	token, err := client.GetConversationToken("a1")
+	if err != nil || token != "tok" {
+		t.Errorf("Unexpected token %q, %v", token, err)
+	}
+}
+
+func TestConversationCredentialsHandler(t *testing.T) {
+	mints := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mints++
+		json.NewEncoder(w).Encode(map[string]string{"signed_url": "wss://example.com/" + string(rune('0'+mints))})
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	authorize := func(r *http.Request) error {
+		if r.Header.Get("Authorization") != "Bearer user" {
+			return errors.New("unauthorized")
+		}
+		return nil
+	}
+
+	get := func(h http.Handler, method, auth string) (*httptest.ResponseRecorder, elevenlabs.ConversationCredentials) {
+		req := httptest.NewRequest(method, "/signed-url", nil)
+		req.Header.Set("Authorization", auth)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		var creds elevenlabs.ConversationCredentials
+		if rec.Code == http.StatusOK {
+			json.NewDecoder(rec.Body).Decode(&creds)
+		}
+		return rec, creds
+	}
+
+	handler := client.NewConversationCredentialsHandler(elevenlabs.ConversationCredentialsHandlerConfig{AgentID: "a1", Authorize: authorize})
+	if rec, _ := get(handler, http.MethodGet, "Bearer intruder"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d for unauthorized user, got %d", http.StatusUnauthorized, rec.Code)
+	}
+	if rec, _ := get(handler, http.MethodPost, "Bearer user"); rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status %d for POST, got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+
+	rec, first := get(handler, http.MethodGet, "Bearer user")
+	if rec.Code != http.StatusOK || first.SignedURL != "wss://example.com/1" || rec.Header().Get("Cache-Control") != "no-store" {
+		t.Fatalf("Unexpected response %d %+v", rec.Code, first)
+	}
+	if d := time.Until(first.ExpiresAt); d < 14*time.Minute || d > 15*time.Minute {
+		t.Errorf("Expected credentials to expire in 15 minutes, got %v", d)
+	}
+	if _, second := get(handler, http.MethodGet, "Bearer user"); second.SignedURL != first.SignedURL || mints != 1 {
+		t.Errorf("Expected cached credentials %q after 1 mint, got %q after %d", first.SignedURL, second.SignedURL, mints)
+	}
+
+	// Credentials can't outlive the signed URLs of the server.
+	handler = client.NewConversationCredentialsHandler(elevenlabs.ConversationCredentialsHandlerConfig{AgentID: "a1", Authorize: authorize, Validity: time.Hour})
+	if _, creds := get(handler, http.MethodGet, "Bearer user"); time.Until(creds.ExpiresAt) > 15*time.Minute {
+		t.Errorf("Expected validity to be limited to 15 minutes, got credentials expiring at %v", creds.ExpiresAt)
+	}
+
+	// A refresh margin as long as the validity falls back to a shorter one instead of disabling caching.
+	handler = client.NewConversationCredentialsHandler(elevenlabs.ConversationCredentialsHandlerConfig{
+		AgentID:       "a1",
+		Authorize:     authorize,
+		Validity:      time.Minute,
+		RefreshMargin: time.Minute,
+	})
+	_, first = get(handler, http.MethodGet, "Bearer user")
+	if _, second := get(handler, http.MethodGet, "Bearer user"); second.SignedURL != first.SignedURL {
+		t.Errorf("Expected cached credentials %q, got %q", first.SignedURL, second.SignedURL)
+	}
+
+	// Credentials within the refresh margin are replaced.
+	handler = client.NewConversationCredentialsHandler(elevenlabs.ConversationCredentialsHandlerConfig{
+		AgentID:   "a1",
+		Authorize: authorize,
+		Validity:  10 * time.Millisecond,
+	})
+	_, first = get(handler, http.MethodGet, "Bearer user")
+	time.Sleep(10 * time.Millisecond)
+	if _, second := get(handler, http.MethodGet, "Bearer user"); second.SignedURL == first.SignedURL {
+		t.Errorf("Expected credentials to be refreshed, got %q again", second.SignedURL)
+	}
+}
@@ -0,0 +1,75 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const maxConversionPollInterval = 1 * time.Minute
+
+// WaitForProjectConversion polls a Studio project with exponential backoff, starting at pollInterval or one second
+// if it isn't positive, until it is no longer converting or queued for conversion, or ctx is done. It is meant to
+// be called after ConvertProject.
+//
+// It returns the converted Project or an error.
+func (c *Client) WaitForProjectConversion(ctx context.Context, projectID string, pollInterval time.Duration) (Project, error) {
+	var project Project
+	err := pollWithBackoff(ctx, pollInterval, maxConversionPollInterval, func() (bool, error) {
+		var err error
+		project, err = c.GetProject(projectID, WithRequestContext(ctx))
+		return !isConverting(project.State), err
+	})
+	if err != nil {
+		return Project{}, err
+	}
+	return project, nil
+}
+
+// WaitForChapterConversion polls a chapter with exponential backoff, starting at pollInterval or one second if it
+// isn't positive, until it is no longer converting or queued for conversion, or ctx is done. It is meant to be
+// called after ConvertChapter.
+//
+// It returns the converted Chapter or an error.
+func (c *Client) WaitForChapterConversion(ctx context.Context, projectID, chapterID string, pollInterval time.Duration) (Chapter, error) {
+	var chapter Chapter
+	err := pollWithBackoff(ctx, pollInterval, maxConversionPollInterval, func() (bool, error) {
+		var err error
+		chapter, err = c.GetChapter(projectID, chapterID, WithRequestContext(ctx))
+		return !isConverting(chapter.State), err
+	})
+	if err != nil {
+		return Chapter{}, err
+	}
+	return chapter, nil
+}
+
+func isConverting(state string) bool {
+	return state == ProjectStateConverting || state == ProjectStateInQueue
+}
+
+// SetParagraphVoices assigns voices to paragraphs of a chapter, for example to give each character of an
+// audiobook their own voice. The chapter must be converted again for the change to be heard.
+//
+// It takes two string arguments representing the IDs of the project and the chapter respectively, a map of
+// block IDs (see ChapterContentBlock) to the ID of the voice to use for that block, and an optional list of
+// RequestOption 'opts' that apply to both the retrieval and the update of the chapter.
+//
+// It returns nil if successful or an error otherwise, including when a block ID is not found in the chapter.
+func (c *Client) SetParagraphVoices(projectID, chapterID string, blockVoices map[string]string, opts ...RequestOption) error {
+	chapter, err := c.GetChapter(projectID, chapterID, opts...)
+	if err != nil {
+		return err
+	}
+	if chapter.Content == nil {
+		return fmt.Errorf("chapter %q has no content", chapterID)
+	}
+
+	for blockID, voiceID := range blockVoices {
+		if !chapter.Content.SetBlockVoice(blockID, voiceID) {
+			return fmt.Errorf("block %q not found in chapter %q", blockID, chapterID)
+		}
+	}
+
+	return c.EditChapter(projectID, chapterID, EditChapterRequest{Content: chapter.Content}, opts...)
+}
@@ -0,0 +1,222 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func testStudioServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	projectPolls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("xi-api-key"); got != mockAPIKey {
+			t.Errorf("Server: expected API Key %q, got %q", mockAPIKey, got)
+		}
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "POST /studio/projects":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse multipart form: %v", err)
+				return
+			}
+			f, fh, err := r.FormFile("from_document")
+			if err != nil {
+				t.Errorf("Server: expected from_document file: %v", err)
+				return
+			}
+			content, _ := io.ReadAll(f)
+			if fh.Filename != "content.txt" || string(content) != "Chapter 1. It was a dark night." || r.FormValue("name") != "Book" {
+				t.Errorf("Server: unexpected project form %q %q %v", fh.Filename, content, r.MultipartForm.Value)
+			}
+			w.Write([]byte(`{"project": {"project_id": "p1", "name": "Book", "state": "default"}}`))
+		case "GET /studio/projects":
+			if r.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"projects": [{"project_id": "p1"}], "has_more": true, "next_cursor": "c1"}`))
+			} else if r.URL.RawQuery == "cursor=c1&page_size=1" {
+				w.Write([]byte(`{"projects": [{"project_id": "p2"}], "has_more": false}`))
+			} else {
+				t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+			}
+		case "GET /studio/projects/p1":
+			state := elevenlabs.ProjectStateConverting
+			if projectPolls++; projectPolls > 2 {
+				state = elevenlabs.ProjectStateDefault
+			}
+			json.NewEncoder(w).Encode(elevenlabs.Project{ProjectID: "p1", State: state, CanBeDownloaded: state == elevenlabs.ProjectStateDefault})
+		case "POST /studio/projects/p1/convert", "POST /studio/projects/p1/chapters/c1/convert":
+			w.Write([]byte(`{"status": "ok"}`))
+		case "GET /studio/projects/p1/chapters":
+			w.Write([]byte(`{"chapters": [{"chapter_id": "c1", "name": "Chapter 1", "state": "default"}]}`))
+		case "GET /studio/projects/p1/chapters/c1":
+			w.Write(testRespBodies["TestGetChapter"])
+		case "POST /studio/projects/p1/chapters/c1":
+			var chapReq elevenlabs.EditChapterRequest
+			if err := json.NewDecoder(r.Body).Decode(&chapReq); err != nil {
+				t.Errorf("Server: failed to decode chapter edit: %v", err)
+				return
+			}
+			blocks := chapReq.Content.Blocks
+			if blocks[0].Nodes[0].VoiceID != "narrator" || blocks[1].Nodes[0].VoiceID != "villain" {
+				t.Errorf("Server: unexpected chapter content %+v", chapReq.Content)
+			}
+			w.Write([]byte(`{"chapter": {}}`))
+		case "GET /studio/projects/p1/snapshots":
+			w.Write([]byte(`{"snapshots": [{"project_snapshot_id": "s1", "project_id": "p1", "created_at_unix": 1700000000, "name": "v1"}]}`))
+		case "POST /studio/projects/p1/snapshots/s1/archive":
+			w.Write([]byte("zip archive"))
+		default:
+			t.Errorf("Server: unexpected request %q", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCreateProject(t *testing.T) {
+	server := testStudioServer(t)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	project, err := client.CreateProject(elevenlabs.CreateProjectRequest{
+		Name:     "Book",
+		FromText: "Chapter 1. It was a dark night.",
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if project.ProjectID != "p1" || project.Name != "Book" {
+		t.Errorf("Unexpected Project: %+v", project)
+	}
+}
+
+func TestGetProjects(t *testing.T) {
+	server := testStudioServer(t)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	var ids []string
+	projResp, nextPage, err := client.GetProjects()
+	for {
+		if err != nil {
+			t.Fatalf("Expected no errors, got error: %q", err)
+		}
+		for _, p := range projResp.Projects {
+			ids = append(ids, p.ProjectID)
+		}
+		if nextPage == nil {
+			break
+		}
+		projResp, nextPage, err = nextPage(elevenlabs.PageSize(1))
+	}
+	if len(ids) != 2 || ids[0] != "p1" || ids[1] != "p2" {
+		t.Errorf("Expected projects [p1 p2], got %v", ids)
+	}
+}
+
+func TestGetChapters(t *testing.T) {
+	server := testStudioServer(t)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	chapResp, nextPage, err := client.GetChapters("p1")
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if nextPage != nil {
+		t.Errorf("Expected no next page function")
+	}
+	if len(chapResp.Chapters) != 1 || chapResp.Chapters[0].ChapterID != "c1" {
+		t.Errorf("Unexpected GetChaptersResponse: %+v", chapResp)
+	}
+}
+
+func TestSetParagraphVoices(t *testing.T) {
+	server := testStudioServer(t)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	err := client.SetParagraphVoices("p1", "c1", map[string]string{"b1": "narrator", "b2": "villain"})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	err = client.SetParagraphVoices("p1", "c1", map[string]string{"missing": "narrator"})
+	if err == nil {
+		t.Errorf("Expected an error for an unknown block ID")
+	}
+}
+
+func TestConvertAndWaitForProject(t *testing.T) {
+	server := testStudioServer(t)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	if err := client.ConvertProject("p1"); err != nil {
+		t.Fatalf("Expected no errors converting, got error: %q", err)
+	}
+	project, err := client.WaitForProjectConversion(context.Background(), "p1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Expected no errors waiting, got error: %q", err)
+	}
+	if project.State != elevenlabs.ProjectStateDefault || !project.CanBeDownloaded {
+		t.Errorf("Unexpected Project after conversion: %+v", project)
+	}
+
+	if err := client.ConvertChapter("p1", "c1"); err != nil {
+		t.Fatalf("Expected no errors converting chapter, got error: %q", err)
+	}
+	chapter, err := client.WaitForChapterConversion(context.Background(), "p1", "c1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Expected no errors waiting for chapter, got error: %q", err)
+	}
+	if chapter.ChapterID != "c1" {
+		t.Errorf("Unexpected Chapter after conversion: %+v", chapter)
+	}
+}
+
+func TestWaitForProjectConversionZeroInterval(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		json.NewEncoder(w).Encode(elevenlabs.Project{ProjectID: "p1", State: elevenlabs.ProjectStateConverting})
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := client.WaitForProjectConversion(ctx, "p1", 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline to be exceeded, got %v", err)
+	}
+	if polls != 1 {
+		t.Errorf("Expected a single poll before the default interval elapses, got %d", polls)
+	}
+}
+
+func TestProjectSnapshots(t *testing.T) {
+	server := testStudioServer(t)
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	snapshots, err := client.GetProjectSnapshots("p1")
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if len(snapshots) != 1 || snapshots[0].ProjectSnapshotID != "s1" {
+		t.Fatalf("Unexpected snapshots: %+v", snapshots)
+	}
+	w := bytes.Buffer{}
+	if err := client.DownloadProjectSnapshot(&w, "p1", snapshots[0].ProjectSnapshotID); err != nil {
+		t.Fatalf("Expected no errors downloading, got error: %q", err)
+	}
+	if w.String() != "zip archive" {
+		t.Errorf("Unexpected snapshot archive %q", w.String())
+	}
+}
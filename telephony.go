@@ -0,0 +1,517 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// TelephonyAudioFormat is the format of the audio of phone calls streamed by media streams, μ-law at 8 kHz. It
+// must be the user input and agent output audio format of agents connected with a TelephonyBridge.
+const TelephonyAudioFormat = "ulaw_8000"
+
+const (
+	// mediaStreamFrameSize is the size of 20 ms of μ-law audio at 8 kHz, the frame size of media streams.
+	mediaStreamFrameSize = 160
+	// mulawSilence is the μ-law encoding of a zero sample, used to pad the last frame of an utterance.
+	mulawSilence = 0xff
+	// mulawBytesPerSecond is the number of bytes of a second of μ-law audio at 8 kHz.
+	mulawBytesPerSecond = 8000
+	// agentTurnEndLead is how long before the audio of the agent sent so far has been played the turn is
+	// considered over, so that its last partial frame is sent before the caller's audio runs out.
+	agentTurnEndLead = 60 * time.Millisecond
+)
+
+// TelephonyBridgeConfig represents the settings of a TelephonyBridge. Calls are connected to an agent when
+// Conversation is set, transcribed when Transcription is set, and otherwise only receive the audio sent with the
+// TelephonyCall methods.
+type TelephonyBridgeConfig struct {
+	// Conversation is the configuration of the conversation each call is connected to.
+	Conversation *ConversationSessionConfig
+	// OnConversation is called before each conversation starts, to register handlers for its events. Audio and
+	// interruptions are handled by the bridge, so handlers registered with OnAudio and OnInterruption are replaced.
+	OnConversation func(call *TelephonyCall, cv *Conversation)
+	// Transcription is the configuration of the realtime speech-to-text session of each call. Its AudioFormat is
+	// set to TelephonyAudioFormat. It is ignored when Conversation is set.
+	Transcription *RealtimeSpeechToTextConfig
+	// OnTranscript is called with the transcripts of the caller when Transcription is set.
+	OnTranscript func(call *TelephonyCall, transcript RealtimeTranscript)
+	// BargeIn clears the audio played to the caller as soon as a transcript of their speech is received, when
+	// Transcription is set. Agents handle interruptions themselves.
+	BargeIn bool
+	// OnCallStarted is called in its own goroutine once a call has started, e.g. to greet the caller with Speak.
+	OnCallStarted func(call *TelephonyCall)
+	// OnCallEnded is called when a call ends, with the error that ended it, if any.
+	OnCallEnded func(call *TelephonyCall, err error)
+}
+
+// TelephonyBridge is an http.Handler accepting the media stream WebSocket connections of a phone system, such as
+// the ones opened by the <Stream> TwiML noun of Twilio, and bridging each call to an agent or to realtime
+// speech-to-text.
+//
+// The audio of the caller is forwarded as it is received, and the audio played to the caller is sent in frames of
+// 20 ms followed by marks, so that the bridge knows what the caller has heard. When the caller interrupts, the
+// audio that hasn't been played yet is cleared.
+type TelephonyBridge struct {
+	client   *Client
+	config   TelephonyBridgeConfig
+	upgrader websocket.Upgrader
+}
+
+// TelephonyCall represents a phone call handled by a TelephonyBridge.
+type TelephonyCall struct {
+	client *Client
+	conn   *websocket.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	streamSID        string
+	callSID          string
+	customParameters map[string]string
+
+	// writeMu guards the connection writes and the playback state.
+	writeMu    sync.Mutex
+	pending    []byte
+	unmarked   bool
+	marks      map[string]bool
+	markSeq    int
+	generation int
+
+	// agentTurnEnd pads and marks the audio of the agent once it has stopped arriving, and agentPlayEnd is when the
+	// agent audio sent so far will have been played.
+	agentTurnEnd *time.Timer
+	agentPlayEnd time.Time
+	agentChunks  int
+
+	mu  sync.Mutex
+	err error
+}
+
+type mediaStreamMessage struct {
+	Event     string            `json:"event"`
+	StreamSID string            `json:"streamSid,omitempty"`
+	Start     *mediaStreamStart `json:"start,omitempty"`
+	Media     *mediaStreamMedia `json:"media,omitempty"`
+	Mark      *mediaStreamMark  `json:"mark,omitempty"`
+}
+
+type mediaStreamStart struct {
+	CallSID          string            `json:"callSid"`
+	CustomParameters map[string]string `json:"customParameters"`
+	MediaFormat      struct {
+		Encoding   string `json:"encoding"`
+		SampleRate int    `json:"sampleRate"`
+	} `json:"mediaFormat"`
+}
+
+type mediaStreamMedia struct {
+	Track   string `json:"track,omitempty"`
+	Payload string `json:"payload"`
+}
+
+type mediaStreamMark struct {
+	Name string `json:"name"`
+}
+
+// telephonyDownstream is the session the audio of the caller is forwarded to.
+type telephonyDownstream interface {
+	SendAudio(chunk []byte) error
+	Close() error
+}
+
+// NewTelephonyBridge returns a TelephonyBridge that connects calls with the client.
+//
+// It takes a TelephonyBridgeConfig argument that contains the settings of the sessions the calls are connected to
+// and the callbacks receiving their events.
+func (c *Client) NewTelephonyBridge(config TelephonyBridgeConfig) *TelephonyBridge {
+	return &TelephonyBridge{client: c, config: config}
+}
+
+func (b *TelephonyBridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := b.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	call := &TelephonyCall{
+		client: b.client,
+		conn:   conn,
+		ctx:    ctx,
+		cancel: cancel,
+		marks:  map[string]bool{},
+	}
+	b.serveCall(call)
+	if b.config.OnCallEnded != nil {
+		b.config.OnCallEnded(call, call.Err())
+	}
+}
+
+func (b *TelephonyBridge) serveCall(call *TelephonyCall) {
+	if err := call.waitForStart(); err != nil {
+		call.setErr(err)
+		return
+	}
+
+	var downstream telephonyDownstream
+	var downstreamDone <-chan struct{}
+	var err error
+	switch {
+	case b.config.Conversation != nil:
+		downstream, downstreamDone, err = b.startConversation(call)
+	case b.config.Transcription != nil:
+		downstream, downstreamDone, err = b.startTranscription(call)
+	}
+	if err != nil {
+		call.setErr(err)
+		return
+	}
+	if downstream != nil {
+		defer func() {
+			downstream.Close()
+			<-downstreamDone
+		}()
+	}
+
+	if b.config.OnCallStarted != nil {
+		go b.config.OnCallStarted(call)
+	}
+	if err := call.readLoop(downstream); err != nil {
+		call.setErr(err)
+	}
+}
+
+func (b *TelephonyBridge) startConversation(call *TelephonyCall) (telephonyDownstream, <-chan struct{}, error) {
+	cv := b.client.NewConversation(*b.config.Conversation)
+	if b.config.OnConversation != nil {
+		b.config.OnConversation(call, cv)
+	}
+
+	onMetadata := cv.onMetadata
+	cv.OnConversationStarted(func(m ConversationMetadata) {
+		if m.UserInputAudioFormat != TelephonyAudioFormat || m.AgentOutputAudioFormat != TelephonyAudioFormat {
+			cv.setErr(fmt.Errorf("agent audio formats must be %s, got %s input and %s output", TelephonyAudioFormat, m.UserInputAudioFormat, m.AgentOutputAudioFormat))
+			cv.Close()
+			return
+		}
+		if onMetadata != nil {
+			onMetadata(m)
+		}
+	})
+	cv.OnAudio(func(e AgentAudioEvent) { call.sendAgentAudio(e.Audio, e.EventID) })
+	cv.OnInterruption(func(InterruptionEvent) { call.Clear() })
+
+	if err := cv.Start(WithRequestContext(call.ctx)); err != nil {
+		return nil, nil, err
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		call.end(cv.Wait())
+	}()
+	return cv, done, nil
+}
+
+func (b *TelephonyBridge) startTranscription(call *TelephonyCall) (telephonyDownstream, <-chan struct{}, error) {
+	config := *b.config.Transcription
+	config.AudioFormat = TelephonyAudioFormat
+	session, err := b.client.RealtimeSpeechToText(config, WithRequestContext(call.ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for transcript := range session.Transcripts() {
+			if b.config.BargeIn && transcript.Text != "" && call.Playing() {
+				call.Clear()
+			}
+			if b.config.OnTranscript != nil {
+				b.config.OnTranscript(call, transcript)
+			}
+		}
+		call.end(session.Err())
+	}()
+	return session, done, nil
+}
+
+// waitForStart reads the messages of the media stream until the call starts.
+func (call *TelephonyCall) waitForStart() error {
+	for {
+		var msg mediaStreamMessage
+		if err := call.conn.ReadJSON(&msg); err != nil {
+			return fmt.Errorf("media stream ended before the call started: %w", err)
+		}
+		if msg.Event != "start" || msg.Start == nil {
+			continue
+		}
+		format := msg.Start.MediaFormat
+		if format.Encoding != "audio/x-mulaw" || format.SampleRate != 8000 {
+			return fmt.Errorf("unsupported media stream format %s at %d Hz", format.Encoding, format.SampleRate)
+		}
+		call.streamSID = msg.StreamSID
+		call.callSID = msg.Start.CallSID
+		call.customParameters = msg.Start.CustomParameters
+		return nil
+	}
+}
+
+func (call *TelephonyCall) readLoop(downstream telephonyDownstream) error {
+	for {
+		var msg mediaStreamMessage
+		if err := call.conn.ReadJSON(&msg); err != nil {
+			if call.ctx.Err() != nil || isExpectedClose(err) {
+				return nil
+			}
+			return err
+		}
+
+		switch msg.Event {
+		case "media":
+			if msg.Media == nil || (msg.Media.Track != "" && msg.Media.Track != "inbound") || downstream == nil {
+				continue
+			}
+			audio, err := base64.StdEncoding.DecodeString(msg.Media.Payload)
+			if err != nil {
+				return fmt.Errorf("failed to decode caller audio: %w", err)
+			}
+			if err := downstream.SendAudio(audio); err != nil && call.ctx.Err() == nil {
+				return err
+			}
+		case "mark":
+			if msg.Mark != nil {
+				call.writeMu.Lock()
+				delete(call.marks, msg.Mark.Name)
+				call.writeMu.Unlock()
+			}
+		case "stop":
+			return nil
+		}
+	}
+}
+
+// end ends the call after the session it is connected to ended, with the error that ended the session, if any.
+func (call *TelephonyCall) end(err error) {
+	if err != nil {
+		call.setErr(err)
+	}
+	call.cancel()
+	call.writeMu.Lock()
+	_ = call.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	call.writeMu.Unlock()
+	call.conn.Close()
+}
+
+// Context returns a context that is cancelled when the call ends.
+func (call *TelephonyCall) Context() context.Context {
+	return call.ctx
+}
+
+// StreamSID returns the ID of the media stream.
+func (call *TelephonyCall) StreamSID() string {
+	return call.streamSID
+}
+
+// CallSID returns the ID of the call assigned by the phone system.
+func (call *TelephonyCall) CallSID() string {
+	return call.callSID
+}
+
+// CustomParameters returns the parameters passed to the media stream by the phone system, such as the <Parameter>
+// nouns of a TwiML <Stream>. The returned map must not be modified.
+func (call *TelephonyCall) CustomParameters() map[string]string {
+	return call.customParameters
+}
+
+// Err returns the error that ended the call, if any.
+func (call *TelephonyCall) Err() error {
+	call.mu.Lock()
+	defer call.mu.Unlock()
+	return call.err
+}
+
+func (call *TelephonyCall) setErr(err error) {
+	call.mu.Lock()
+	defer call.mu.Unlock()
+	if call.err == nil {
+		call.err = err
+	}
+}
+
+// Playing reports whether audio sent to the caller may not have been played yet.
+func (call *TelephonyCall) Playing() bool {
+	call.writeMu.Lock()
+	defer call.writeMu.Unlock()
+	return call.unmarked || len(call.marks) > 0
+}
+
+// SendAudio plays μ-law 8 kHz audio to the caller. It is sent in frames of 20 ms; the remainder is held until more
+// audio is sent or it is padded with silence by Mark.
+func (call *TelephonyCall) SendAudio(audio []byte) error {
+	call.writeMu.Lock()
+	defer call.writeMu.Unlock()
+	return call.sendAudio(audio)
+}
+
+func (call *TelephonyCall) sendAudio(audio []byte) error {
+	call.pending = append(call.pending, audio...)
+	call.unmarked = call.unmarked || len(audio) > 0
+	for len(call.pending) >= mediaStreamFrameSize {
+		if err := call.sendFrame(call.pending[:mediaStreamFrameSize]); err != nil {
+			return err
+		}
+		call.pending = call.pending[mediaStreamFrameSize:]
+	}
+	if len(call.pending) == 0 {
+		call.pending = nil
+	}
+	return nil
+}
+
+func (call *TelephonyCall) sendFrame(frame []byte) error {
+	return call.conn.WriteJSON(mediaStreamMessage{
+		Event:     "media",
+		StreamSID: call.streamSID,
+		Media:     &mediaStreamMedia{Payload: base64.StdEncoding.EncodeToString(frame)},
+	})
+}
+
+// sendAgentAudio plays a chunk of the audio of the agent. Chunks rarely hold a whole number of frames, so the
+// remainder is kept for the next chunk, and only padded with silence and followed by a mark named after the last
+// event once no more audio arrived before the audio already sent is about to be played.
+func (call *TelephonyCall) sendAgentAudio(audio []byte, eventID int) {
+	call.writeMu.Lock()
+	defer call.writeMu.Unlock()
+	if err := call.sendAudio(audio); err != nil {
+		return
+	}
+
+	now := time.Now()
+	if call.agentPlayEnd.Before(now) {
+		call.agentPlayEnd = now
+	}
+	call.agentPlayEnd = call.agentPlayEnd.Add(time.Duration(len(audio)) * time.Second / mulawBytesPerSecond)
+	if call.agentTurnEnd != nil {
+		call.agentTurnEnd.Stop()
+	}
+	call.agentChunks++
+	generation, chunks := call.generation, call.agentChunks
+	call.agentTurnEnd = time.AfterFunc(call.agentPlayEnd.Sub(now)-agentTurnEndLead, func() {
+		call.writeMu.Lock()
+		defer call.writeMu.Unlock()
+		// More audio arrived before the timer could be stopped, or the turn was cleared.
+		if call.agentChunks != chunks || call.generation != generation || call.ctx.Err() != nil || !call.unmarked {
+			return
+		}
+		_ = call.mark("agent-" + strconv.Itoa(eventID))
+	})
+}
+
+// Mark sends the audio held by SendAudio, padded with silence to a full frame, followed by a mark with the given
+// name. The phone system echoes the mark when the audio sent before it has been played, after which Playing
+// reports false unless more audio was sent.
+func (call *TelephonyCall) Mark(name string) error {
+	call.writeMu.Lock()
+	defer call.writeMu.Unlock()
+	return call.mark(name)
+}
+
+func (call *TelephonyCall) mark(name string) error {
+	if len(call.pending) > 0 {
+		frame := append(call.pending, bytes.Repeat([]byte{mulawSilence}, mediaStreamFrameSize-len(call.pending))...)
+		call.pending = nil
+		if err := call.sendFrame(frame); err != nil {
+			return err
+		}
+	}
+	if err := call.conn.WriteJSON(mediaStreamMessage{
+		Event:     "mark",
+		StreamSID: call.streamSID,
+		Mark:      &mediaStreamMark{Name: name},
+	}); err != nil {
+		return err
+	}
+	call.marks[name] = true
+	call.unmarked = false
+	return nil
+}
+
+// Clear stops the audio played to the caller, discarding the audio that was sent but not played yet. Calls to
+// Speak in progress return ErrPlaybackCleared.
+func (call *TelephonyCall) Clear() error {
+	call.writeMu.Lock()
+	defer call.writeMu.Unlock()
+	call.pending = nil
+	call.unmarked = false
+	call.marks = map[string]bool{}
+	call.generation++
+	call.agentPlayEnd = time.Time{}
+	if call.agentTurnEnd != nil {
+		call.agentTurnEnd.Stop()
+	}
+	return call.conn.WriteJSON(mediaStreamMessage{Event: "clear", StreamSID: call.streamSID})
+}
+
+// Speak converts text to speech in the TelephonyAudioFormat and streams it to the caller, followed by a mark.
+//
+// It takes the ID of the voice, a TextToSpeechRequest argument and an optional list of QueryFunc 'queries' like
+// TextToSpeechStream. The output format is always TelephonyAudioFormat.
+//
+// It returns ErrPlaybackCleared if the audio is cleared before it has been entirely sent, the context error if
+// the call ends, or another error if the conversion fails.
+func (call *TelephonyCall) Speak(voiceID string, ttsReq TextToSpeechRequest, queries ...QueryFunc) error {
+	call.writeMu.Lock()
+	w := &telephonyPlaybackWriter{call: call, generation: call.generation}
+	call.writeMu.Unlock()
+
+	queries = append(queries, OutputFormat(TelephonyAudioFormat))
+	if err := call.client.TextToSpeechStream(w, voiceID, ttsReq, queries...); err != nil {
+		return err
+	}
+
+	call.writeMu.Lock()
+	defer call.writeMu.Unlock()
+	if err := w.check(); err != nil {
+		return err
+	}
+	call.markSeq++
+	return call.mark("speech-" + strconv.Itoa(call.markSeq))
+}
+
+// telephonyPlaybackWriter plays the audio written to it to the caller until playback is cleared.
+type telephonyPlaybackWriter struct {
+	call       *TelephonyCall
+	generation int
+}
+
+// check must be called with the write lock of the call held.
+func (w *telephonyPlaybackWriter) check() error {
+	if err := w.call.ctx.Err(); err != nil {
+		return err
+	}
+	if w.call.generation != w.generation {
+		return ErrPlaybackCleared
+	}
+	return nil
+}
+
+func (w *telephonyPlaybackWriter) Write(p []byte) (int, error) {
+	w.call.writeMu.Lock()
+	defer w.call.writeMu.Unlock()
+	if err := w.check(); err != nil {
+		return 0, err
+	}
+	if err := w.call.sendAudio(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
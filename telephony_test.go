@@ -0,0 +1,245 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+// dialTestMediaStream connects to a TelephonyBridge like a phone system would, and starts a call.
+func dialTestMediaStream(t *testing.T, bridge http.Handler) (*websocket.Conn, func()) {
+	t.Helper()
+	server := httptest.NewServer(bridge)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to bridge: %v", err)
+	}
+	conn.WriteJSON(map[string]interface{}{"event": "connected", "protocol": "Call", "version": "1.0.0"})
+	conn.WriteJSON(map[string]interface{}{
+		"event":     "start",
+		"streamSid": "MZ1",
+		"start": map[string]interface{}{
+			"callSid":          "CA1",
+			"customParameters": map[string]string{"caller": "+15550100"},
+			"mediaFormat":      map[string]interface{}{"encoding": "audio/x-mulaw", "sampleRate": 8000, "channels": 1},
+		},
+	})
+	return conn, func() {
+		conn.Close()
+		server.Close()
+	}
+}
+
+// readMediaStream reads the messages sent by the bridge up to and including the first one of the given event,
+// returning the audio frames received before it.
+func readMediaStream(t *testing.T, conn *websocket.Conn, event string) (frames [][]byte, msg map[string]interface{}) {
+	t.Helper()
+	for {
+		msg = nil
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatalf("Failed to read %s event: %v", event, err)
+		}
+		if msg["streamSid"] != "MZ1" {
+			t.Errorf("Unexpected stream SID in %v", msg)
+		}
+		if msg["event"] == event {
+			return frames, msg
+		}
+		if msg["event"] != "media" {
+			t.Fatalf("Expected %s event, got %v", event, msg)
+		}
+		audio, _ := base64.StdEncoding.DecodeString(msg["media"].(map[string]interface{})["payload"].(string))
+		frames = append(frames, audio)
+	}
+}
+
+func sendTestCallerAudio(conn *websocket.Conn, audio string) {
+	conn.WriteJSON(map[string]interface{}{
+		"event":     "media",
+		"streamSid": "MZ1",
+		"media":     map[string]string{"track": "inbound", "chunk": "1", "timestamp": "20", "payload": base64.StdEncoding.EncodeToString([]byte(audio))},
+	})
+}
+
+func TestTelephonyBridgeConversation(t *testing.T) {
+	agent := testWebSocketServer(t, "agent_id=a1", func(conn *websocket.Conn) {
+		var msg map[string]interface{}
+		conn.ReadJSON(&msg)
+		conn.WriteJSON(map[string]interface{}{
+			"type": "conversation_initiation_metadata",
+			"conversation_initiation_metadata_event": map[string]string{
+				"conversation_id": "conv1", "agent_output_audio_format": "ulaw_8000", "user_input_audio_format": "ulaw_8000",
+			},
+		})
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Errorf("Server: failed to read user audio: %v", err)
+			return
+		}
+		if audio, _ := base64.StdEncoding.DecodeString(msg["user_audio_chunk"].(string)); string(audio) != "caller" {
+			t.Errorf("Server: unexpected user audio %v", msg)
+		}
+		// Two chunks of 125 ms that don't divide into frames.
+		for id := 1; id <= 2; id++ {
+			conn.WriteJSON(map[string]interface{}{"type": "audio", "audio_event": map[string]interface{}{
+				"event_id": id, "audio_base_64": base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{0x7f}, 1000)),
+			}})
+		}
+		// The caller speaks again once the turn has been played.
+		conn.ReadJSON(&msg)
+		conn.WriteJSON(map[string]interface{}{"type": "interruption", "interruption_event": map[string]int{"event_id": 3}})
+		conn.ReadMessage()
+	})
+	defer agent.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), agent.URL, mockAPIKey, mockTimeout)
+	ended := make(chan error, 1)
+	var conversationID string
+	bridge := client.NewTelephonyBridge(elevenlabs.TelephonyBridgeConfig{
+		Conversation: &elevenlabs.ConversationSessionConfig{AgentID: "a1"},
+		OnConversation: func(call *elevenlabs.TelephonyCall, cv *elevenlabs.Conversation) {
+			cv.OnConversationStarted(func(m elevenlabs.ConversationMetadata) { conversationID = m.ConversationID })
+		},
+		OnCallEnded: func(call *elevenlabs.TelephonyCall, err error) {
+			if call.CallSID() != "CA1" || call.CustomParameters()["caller"] != "+15550100" {
+				t.Errorf("Unexpected call %s with parameters %v", call.CallSID(), call.CustomParameters())
+			}
+			ended <- err
+		},
+	})
+
+	conn, closeConn := dialTestMediaStream(t, bridge)
+	defer closeConn()
+	sendTestCallerAudio(conn, "caller")
+
+	// The chunks are sent without silence between them, and only the end of the turn is padded.
+	frames, mark := readMediaStream(t, conn, "mark")
+	expLast := append(bytes.Repeat([]byte{0x7f}, 80), bytes.Repeat([]byte{0xff}, 80)...)
+	if len(frames) != 13 || !bytes.Equal(frames[12], expLast) {
+		t.Fatalf("Expected 13 frames ending with a padded one, got %d", len(frames))
+	}
+	for i, frame := range frames[:12] {
+		if !bytes.Equal(frame, bytes.Repeat([]byte{0x7f}, 160)) {
+			t.Errorf("Expected frame %d to hold only agent audio, got %v", i, frame)
+		}
+	}
+	if mark["mark"].(map[string]interface{})["name"] != "agent-2" {
+		t.Errorf("Unexpected mark %v", mark)
+	}
+	sendTestCallerAudio(conn, "again")
+	readMediaStream(t, conn, "clear")
+
+	conn.WriteJSON(map[string]interface{}{"event": "stop", "streamSid": "MZ1"})
+	if err := <-ended; err != nil {
+		t.Errorf("Expected call to end without errors, got error: %q", err)
+	}
+	if conversationID != "conv1" {
+		t.Errorf("Expected conversation started handler to be called, got conversation ID %q", conversationID)
+	}
+}
+
+func TestTelephonyBridgeTranscription(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/text-to-speech/v1/stream":
+			if r.URL.RawQuery != "output_format=ulaw_8000" {
+				t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+			}
+			w.Write(bytes.Repeat([]byte{0x7f}, 500))
+		case "/speech-to-text/realtime":
+			if r.URL.Query().Get("audio_format") != "ulaw_8000" {
+				t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+			}
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				t.Errorf("Server: failed to upgrade connection: %v", err)
+				return
+			}
+			defer conn.Close()
+			var chunk map[string]interface{}
+			if err := conn.ReadJSON(&chunk); err != nil {
+				t.Errorf("Server: failed to read audio chunk: %v", err)
+				return
+			}
+			conn.WriteJSON(map[string]interface{}{"message_type": "partial_transcript", "text": "wait"})
+			conn.ReadMessage()
+		default:
+			t.Errorf("Server: unexpected path %q", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+	transcripts := make(chan string, 1)
+	spoken := make(chan error, 1)
+	ended := make(chan error, 1)
+	bridge := client.NewTelephonyBridge(elevenlabs.TelephonyBridgeConfig{
+		Transcription: &elevenlabs.RealtimeSpeechToTextConfig{ModelID: "scribe_v2_realtime"},
+		BargeIn:       true,
+		OnCallStarted: func(call *elevenlabs.TelephonyCall) {
+			spoken <- call.Speak("v1", elevenlabs.TextToSpeechRequest{Text: "Hello, how can I help?"})
+		},
+		OnTranscript: func(call *elevenlabs.TelephonyCall, transcript elevenlabs.RealtimeTranscript) {
+			if call.Playing() {
+				t.Errorf("Expected playback to be cleared before transcript is delivered")
+			}
+			transcripts <- transcript.Text
+		},
+		OnCallEnded: func(call *elevenlabs.TelephonyCall, err error) { ended <- err },
+	})
+
+	conn, closeConn := dialTestMediaStream(t, bridge)
+	defer closeConn()
+
+	frames, mark := readMediaStream(t, conn, "mark")
+	if len(frames) != 4 || len(frames[3]) != 160 {
+		t.Errorf("Expected 4 frames of 160 bytes, got %v", frames)
+	}
+	if err := <-spoken; err != nil {
+		t.Errorf("Expected no errors speaking, got error: %q", err)
+	}
+
+	// The caller speaks before the greeting has been played.
+	sendTestCallerAudio(conn, "caller")
+	readMediaStream(t, conn, "clear")
+	if text := <-transcripts; text != "wait" {
+		t.Errorf("Unexpected transcript %q", text)
+	}
+
+	conn.WriteJSON(map[string]interface{}{"event": "mark", "streamSid": "MZ1", "mark": mark["mark"]})
+	conn.WriteJSON(map[string]interface{}{"event": "stop", "streamSid": "MZ1"})
+	if err := <-ended; err != nil {
+		t.Errorf("Expected call to end without errors, got error: %q", err)
+	}
+}
+
+func TestTelephonyBridgeUnsupportedFormat(t *testing.T) {
+	client := elevenlabs.NewMockClient(context.Background(), "http://localhost", mockAPIKey, mockTimeout)
+	ended := make(chan error, 1)
+	bridge := client.NewTelephonyBridge(elevenlabs.TelephonyBridgeConfig{
+		OnCallEnded: func(call *elevenlabs.TelephonyCall, err error) { ended <- err },
+	})
+	server := httptest.NewServer(bridge)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to bridge: %v", err)
+	}
+	defer conn.Close()
+	conn.WriteJSON(map[string]interface{}{
+		"event": "start",
+		"start": map[string]interface{}{"mediaFormat": map[string]interface{}{"encoding": "audio/l16", "sampleRate": 16000}},
+	})
+	if err := <-ended; err == nil || errors.Is(err, context.Canceled) {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
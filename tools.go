@@ -0,0 +1,214 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ToolServer is an http.Handler serving the webhook tools of agents. Tools are registered with RegisterTool from
+// Go functions taking a typed parameters struct, from which the JSON schema of the tool is generated, so that the
+// definitions returned by Tools always match the implementations.
+//
+// Each tool is served at the path of its name under the handler, e.g. a tool named "get_order" of a ToolServer
+// mounted at https://example.com/tools/ is called with POST https://example.com/tools/get_order. Its parameters
+// are sent as the JSON request body and its result is returned as the JSON response body.
+type ToolServer struct {
+	baseURL string
+	headers map[string]string
+	tools   map[string]*registeredTool
+}
+
+type registeredTool struct {
+	definition AgentTool
+	handle     func(ctx context.Context, body []byte) (interface{}, error)
+}
+
+// ToolCallError is returned by ToolServer when a call doesn't match the schema of the tool. Handlers can also
+// return it to report invalid arguments, in which case the agent gets a 400 Bad Request response instead of a
+// 500 Internal Server Error.
+type ToolCallError struct {
+	Message string
+}
+
+func (e *ToolCallError) Error() string {
+	return fmt.Sprintf("invalid tool call: %s", e.Message)
+}
+
+// NewToolServer returns a ToolServer to be mounted at baseURL, the public URL the agents call.
+//
+// It takes an optional map of HTTP headers that are added to the tool definitions, so that the agents send them
+// with every call, and are required on incoming calls. A header holding a shared secret is a simple way to
+// reject calls that don't come from the agents.
+func NewToolServer(baseURL string, headers map[string]string) *ToolServer {
+	return &ToolServer{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		headers: headers,
+		tools:   map[string]*registeredTool{},
+	}
+}
+
+// RegisterTool registers a webhook tool of a ToolServer. The JSON schema of the tool parameters is generated from
+// P with JSONSchemaFor, and the result of fn, of any type that encoding/json can marshal, is returned to the agent.
+//
+// It returns an error if a tool with the same name is already registered or if P isn't a struct that
+// JSONSchemaFor can describe.
+func RegisterTool[P, R any](s *ToolServer, name, description string, fn func(ctx context.Context, params P) (R, error)) error {
+	if _, exists := s.tools[name]; exists {
+		return fmt.Errorf("tool %q is already registered", name)
+	}
+	var zero P
+	schema, err := JSONSchemaFor(zero)
+	if err != nil {
+		return fmt.Errorf("tool %q: %w", name, err)
+	}
+	if schema.Type != "object" || schema.Properties == nil {
+		return fmt.Errorf("tool %q: parameters must be a struct, got %T", name, zero)
+	}
+
+	s.tools[name] = &registeredTool{
+		definition: AgentTool{
+			Type:        AgentToolTypeWebhook,
+			Name:        name,
+			Description: description,
+			APISchema: &WebhookToolAPISchema{
+				URL:               s.baseURL + "/" + name,
+				Method:            http.MethodPost,
+				RequestHeaders:    s.headers,
+				RequestBodySchema: &schema,
+			},
+		},
+		handle: func(ctx context.Context, body []byte) (interface{}, error) {
+			if err := validateToolCall(schema, body); err != nil {
+				return nil, err
+			}
+			var params P
+			dec := json.NewDecoder(bytes.NewReader(body))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(&params); err != nil {
+				return nil, &ToolCallError{Message: err.Error()}
+			}
+			return fn(ctx, params)
+		},
+	}
+	return nil
+}
+
+// Tools returns the definitions of the registered tools, sorted by name, to be set in AgentPrompt.Tools.
+func (s *ToolServer) Tools() []AgentTool {
+	tools := make([]AgentTool, 0, len(s.tools))
+	for _, tool := range s.tools {
+		tools = append(tools, tool.definition)
+	}
+	sort.Slice(tools, func(i, j int) bool { return tools[i].Name < tools[j].Name })
+	return tools
+}
+
+func (s *ToolServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tool, ok := s.tools[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]]
+	if !ok {
+		writeToolResponse(w, http.StatusNotFound, toolErrorResponse{Error: "unknown tool"})
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeToolResponse(w, http.StatusMethodNotAllowed, toolErrorResponse{Error: "method not allowed"})
+		return
+	}
+	for key, value := range s.headers {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(key)), []byte(value)) != 1 {
+			writeToolResponse(w, http.StatusUnauthorized, toolErrorResponse{Error: "unauthorized"})
+			return
+		}
+	}
+
+	body := bytes.Buffer{}
+	if _, err := body.ReadFrom(http.MaxBytesReader(w, r.Body, 1<<20)); err != nil {
+		writeToolResponse(w, http.StatusBadRequest, toolErrorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := tool.handle(r.Context(), body.Bytes())
+	if err != nil {
+		status := http.StatusInternalServerError
+		var callErr *ToolCallError
+		if errors.As(err, &callErr) {
+			status = http.StatusBadRequest
+		}
+		writeToolResponse(w, status, toolErrorResponse{Error: err.Error()})
+		return
+	}
+	writeToolResponse(w, http.StatusOK, result)
+}
+
+type toolErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeToolResponse(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// validateToolCall checks that body is a JSON object holding the required parameters of the schema, with values
+// among the allowed ones for enumerations, including those of the elements of arrays. Types are checked when the
+// body is decoded into the parameters struct.
+func validateToolCall(schema JSONSchema, body []byte) error {
+	var args map[string]json.RawMessage
+	if err := json.Unmarshal(body, &args); err != nil {
+		return &ToolCallError{Message: "parameters must be a JSON object"}
+	}
+	for _, name := range schema.Required {
+		if _, ok := args[name]; !ok {
+			return &ToolCallError{Message: fmt.Sprintf("missing required parameter %q", name)}
+		}
+	}
+	for name, prop := range schema.Properties {
+		raw, ok := args[name]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		if len(prop.Enum) > 0 && !containsJSONValue(prop.Enum, raw) {
+			return &ToolCallError{Message: fmt.Sprintf("parameter %q must be one of %v", name, prop.Enum)}
+		}
+		if prop.Items == nil || len(prop.Items.Enum) == 0 {
+			continue
+		}
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return &ToolCallError{Message: fmt.Sprintf("parameter %q must be an array", name)}
+		}
+		for _, elem := range elems {
+			if !containsJSONValue(prop.Items.Enum, elem) {
+				return &ToolCallError{Message: fmt.Sprintf("elements of parameter %q must be one of %v", name, prop.Items.Enum)}
+			}
+		}
+	}
+	return nil
+}
+
+// containsJSONValue reports whether raw encodes one of values. Values are compared by their canonical encoding,
+// so that e.g. 1.0 matches the integer 1.
+func containsJSONValue(values []interface{}, raw json.RawMessage) bool {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return false
+	}
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	for _, value := range values {
+		if b, err := json.Marshal(value); err == nil && bytes.Equal(b, canonical) {
+			return true
+		}
+	}
+	return false
+}
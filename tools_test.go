@@ -0,0 +1,225 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+type testOrderParams struct {
+	OrderID string   `json:"order_id" description:"ID of the order"`
+	Detail  string   `json:"detail,omitempty" enum:"summary,full"`
+	Items   []int    `json:"items,omitempty"`
+	Urgent  *bool    `json:"urgent"`
+	Weight  float64  `json:"weight,omitempty"`
+	Tags    []string `json:"-"`
+}
+
+type testOrderResult struct {
+	Status string `json:"status"`
+}
+
+func TestJSONSchemaFor(t *testing.T) {
+	schema, err := elevenlabs.JSONSchemaFor(testOrderParams{})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	exp := elevenlabs.JSONSchema{
+		Type: "object",
+		Properties: map[string]elevenlabs.JSONSchema{
+			"order_id": {Type: "string", Description: "ID of the order"},
+			"detail":   {Type: "string", Enum: []interface{}{"summary", "full"}},
+			"items":    {Type: "array", Items: &elevenlabs.JSONSchema{Type: "integer"}},
+			"urgent":   {Type: "boolean"},
+			"weight":   {Type: "number"},
+		},
+		Required: []string{"order_id"},
+	}
+	if !reflect.DeepEqual(schema, exp) {
+		t.Errorf("Expected schema %+v, got %+v", exp, schema)
+	}
+
+	schema, err = elevenlabs.JSONSchemaFor(struct {
+		Attachment []byte   `json:"attachment"`
+		Checksum   [4]uint8 `json:"checksum"`
+	}{})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if prop := schema.Properties["attachment"]; prop.Type != "string" || prop.ContentEncoding != "base64" || prop.Items != nil {
+		t.Errorf("Expected byte slice to be described as a base64 string, got %+v", prop)
+	}
+	if prop := schema.Properties["checksum"]; prop.Type != "array" || prop.Items == nil || prop.Items.Type != "integer" {
+		t.Errorf("Expected byte array to be described as an array, got %+v", prop)
+	}
+
+	if _, err := elevenlabs.JSONSchemaFor(struct{ C chan int }{}); err == nil {
+		t.Errorf("Expected an error for a channel field")
+	}
+}
+
+func TestToolServer(t *testing.T) {
+	headers := map[string]string{"X-Tool-Secret": "s3cret"}
+	tools := elevenlabs.NewToolServer("https://example.com/tools/", headers)
+	err := elevenlabs.RegisterTool(tools, "get_order", "Looks up an order", func(ctx context.Context, p testOrderParams) (testOrderResult, error) {
+		switch p.OrderID {
+		case "42":
+			return testOrderResult{Status: "shipped (" + p.Detail + ")"}, nil
+		case "bad":
+			return testOrderResult{}, &elevenlabs.ToolCallError{Message: "malformed order ID"}
+		}
+		return testOrderResult{}, errors.New("database unavailable")
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors registering tool, got error: %q", err)
+	}
+	if err := elevenlabs.RegisterTool(tools, "get_order", "", func(ctx context.Context, p testOrderParams) (string, error) { return "", nil }); err == nil {
+		t.Errorf("Expected an error registering a duplicate tool")
+	}
+	if err := elevenlabs.RegisterTool(tools, "bad_params", "", func(ctx context.Context, p string) (string, error) { return "", nil }); err == nil {
+		t.Errorf("Expected an error registering a tool without struct parameters")
+	}
+
+	defs := tools.Tools()
+	if len(defs) != 1 || defs[0].Type != elevenlabs.AgentToolTypeWebhook || defs[0].APISchema.URL != "https://example.com/tools/get_order" ||
+		defs[0].APISchema.RequestHeaders["X-Tool-Secret"] != "s3cret" || defs[0].APISchema.RequestBodySchema.Required[0] != "order_id" {
+		t.Errorf("Unexpected tool definitions: %+v", defs)
+	}
+
+	testCases := []struct {
+		name      string
+		method    string
+		path      string
+		secret    string
+		body      string
+		expStatus int
+		expBody   string
+	}{
+		{name: "Success", path: "/get_order", body: `{"order_id": "42", "detail": "full"}`, expStatus: http.StatusOK, expBody: `{"status":"shipped (full)"}`},
+		{name: "Missing secret", path: "/get_order", secret: "-", body: `{"order_id": "42"}`, expStatus: http.StatusUnauthorized},
+		{name: "Unknown tool", path: "/get_invoice", body: `{}`, expStatus: http.StatusNotFound},
+		{name: "Wrong method", method: http.MethodGet, path: "/get_order", expStatus: http.StatusMethodNotAllowed},
+		{name: "Missing parameter", path: "/get_order", body: `{"detail": "full"}`, expStatus: http.StatusBadRequest, expBody: `{"error":"invalid tool call: missing required parameter \"order_id\""}`},
+		{name: "Invalid enum", path: "/get_order", body: `{"order_id": "42", "detail": "brief"}`, expStatus: http.StatusBadRequest},
+		{name: "Wrong type", path: "/get_order", body: `{"order_id": 42}`, expStatus: http.StatusBadRequest},
+		{name: "Unknown parameter", path: "/get_order", body: `{"order_id": "42", "color": "red"}`, expStatus: http.StatusBadRequest},
+		{name: "Handler call error", path: "/get_order", body: `{"order_id": "bad"}`, expStatus: http.StatusBadRequest},
+		{name: "Handler failure", path: "/get_order", body: `{"order_id": "7"}`, expStatus: http.StatusInternalServerError, expBody: `{"error":"database unavailable"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/tools"+tc.path, strings.NewReader(tc.body))
+			if tc.secret != "-" {
+				req.Header.Set("X-Tool-Secret", "s3cret")
+			}
+			rec := httptest.NewRecorder()
+			tools.ServeHTTP(rec, req)
+			if rec.Code != tc.expStatus {
+				t.Errorf("Expected status %d, got %d: %s", tc.expStatus, rec.Code, rec.Body.String())
+			}
+			if tc.expBody != "" && strings.TrimSpace(rec.Body.String()) != tc.expBody {
+				t.Errorf("Expected body %s, got %s", tc.expBody, rec.Body.String())
+			}
+			var v interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &v); err != nil {
+				t.Errorf("Expected a JSON response, got %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+type testAddress struct {
+	City    string `json:"city"`
+	Country string `json:"country,omitempty"`
+}
+
+type testAuditInfo struct {
+	Reason string `json:"reason"`
+}
+
+type testShipParams struct {
+	testAddress
+	*testAuditInfo
+	City     string   `json:"city" description:"Overrides the embedded city"`
+	Priority int      `json:"priority" enum:"1,2,3"`
+	Options  []string `json:"options,omitempty" enum:"gift,express"`
+}
+
+type testTreeParams struct {
+	Name     string           `json:"name"`
+	Children []testTreeParams `json:"children"`
+}
+
+func TestJSONSchemaForEmbeddedAndEnums(t *testing.T) {
+	schema, err := elevenlabs.JSONSchemaFor(testShipParams{})
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	exp := elevenlabs.JSONSchema{
+		Type: "object",
+		Properties: map[string]elevenlabs.JSONSchema{
+			"city":     {Type: "string", Description: "Overrides the embedded city"},
+			"country":  {Type: "string"},
+			"reason":   {Type: "string"},
+			"priority": {Type: "integer", Enum: []interface{}{int64(1), int64(2), int64(3)}},
+			"options":  {Type: "array", Items: &elevenlabs.JSONSchema{Type: "string", Enum: []interface{}{"gift", "express"}}},
+		},
+		// Fields promoted from an embedded pointer may be absent.
+		Required: []string{"city", "priority"},
+	}
+	if !reflect.DeepEqual(schema, exp) {
+		t.Errorf("Expected schema %+v, got %+v", exp, schema)
+	}
+
+	if _, err := elevenlabs.JSONSchemaFor(testTreeParams{}); err == nil || !strings.Contains(err.Error(), "recursive") {
+		t.Errorf("Expected an error for a recursive type, got %v", err)
+	}
+	if _, err := elevenlabs.JSONSchemaFor(struct {
+		N int `json:"n" enum:"1,two"`
+	}{}); err == nil {
+		t.Errorf("Expected an error for an invalid enum value")
+	}
+}
+
+func TestToolServerEmbeddedAndEnums(t *testing.T) {
+	tools := elevenlabs.NewToolServer("https://example.com/tools/", nil)
+	err := elevenlabs.RegisterTool(tools, "ship", "", func(ctx context.Context, p testShipParams) (string, error) {
+		return fmt.Sprintf("%s/%s %d %v", p.City, p.Country, p.Priority, p.Options), nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no errors registering tool, got error: %q", err)
+	}
+
+	for _, tc := range []struct {
+		body      string
+		expStatus int
+		expBody   string
+	}{
+		{body: `{"city": "Paris", "country": "FR", "priority": 2, "options": ["gift"]}`, expStatus: http.StatusOK, expBody: `"Paris/FR 2 [gift]"`},
+		{body: `{"city": "Paris", "priority": 3}`, expStatus: http.StatusOK, expBody: `"Paris/ 3 []"`},
+		{body: `{"city": "Paris", "priority": 4}`, expStatus: http.StatusBadRequest},
+		{body: `{"city": "Paris", "priority": "2"}`, expStatus: http.StatusBadRequest},
+		{body: `{"city": "Paris", "priority": 1, "options": ["gift", "drone"]}`, expStatus: http.StatusBadRequest},
+	} {
+		rec := httptest.NewRecorder()
+		tools.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/tools/ship", strings.NewReader(tc.body)))
+		if rec.Code != tc.expStatus {
+			t.Errorf("%s: expected status %d, got %d: %s", tc.body, tc.expStatus, rec.Code, rec.Body.String())
+		}
+		if tc.expBody != "" && strings.TrimSpace(rec.Body.String()) != tc.expBody {
+			t.Errorf("%s: expected body %s, got %s", tc.body, tc.expBody, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VoiceCatalog represents the voices of an account, either as exported by SnapshotVoices or as desired. It is
+// saved as Go source with WriteVoiceCatalog, so that it can be edited, reviewed and compiled into the program
+// that applies it, without a JSON or YAML file.
+type VoiceCatalog struct {
+	Voices []VoiceSpec
+}
+
+// VoiceSpec represents a voice of a VoiceCatalog.
+type VoiceSpec struct {
+	VoiceID     string // Empty for voices to create. Voices without an ID are matched to existing ones by name.
+	Name        string
+	Description string
+	Labels      map[string]string
+	Settings    *VoiceSettings    // Nil to leave the settings unmanaged
+	Samples     []VoiceSampleSpec // Nil to leave the samples unmanaged
+}
+
+// VoiceSampleSpec represents a sample of a VoiceSpec. Samples are matched to existing ones by Hash, as reported
+// by VoiceSample.Hash. Samples without a match are uploaded from FilePath; their Hash must then be copied from
+// a new snapshot. Until it is, PlanVoiceCatalog refuses to upload them again if the voice already has a sample
+// with the same file name.
+type VoiceSampleSpec struct {
+	SampleID string
+	FileName string
+	Hash     string
+	FilePath string // Local file holding the sample, required to upload new samples
+}
+
+// Actions of a VoiceChange.
+const (
+	VoiceChangeCreate         = "create"          // AddVoice, followed by EditVoiceSettings if settings are managed
+	VoiceChangeUpdate         = "update"          // EditVoice, which also uploads the new samples
+	VoiceChangeUpdateSettings = "update_settings" // EditVoiceSettings
+	VoiceChangeDeleteSample   = "delete_sample"   // DeleteSample
+	VoiceChangeDelete         = "delete"          // DeleteVoice
+)
+
+// VoiceChange represents a change of a VoicePlan.
+type VoiceChange struct {
+	Action   string // One of the VoiceChange constants
+	VoiceID  string // Empty for VoiceChangeCreate
+	Name     string
+	SampleID string               // Set for VoiceChangeDeleteSample
+	Request  *AddEditVoiceRequest // Set for VoiceChangeCreate and VoiceChangeUpdate
+	Settings *VoiceSettings       // Set for VoiceChangeUpdateSettings, and VoiceChangeCreate if settings are managed
+}
+
+func (ch VoiceChange) String() string {
+	switch ch.Action {
+	case VoiceChangeCreate:
+		return fmt.Sprintf("+ create voice %q with %d samples", ch.Name, len(ch.Request.FilePaths))
+	case VoiceChangeUpdate:
+		s := fmt.Sprintf("~ update voice %q (%s)", ch.Name, ch.VoiceID)
+		if n := len(ch.Request.FilePaths); n > 0 {
+			s += fmt.Sprintf(", adding %d samples", n)
+		}
+		return s
+	case VoiceChangeUpdateSettings:
+		return fmt.Sprintf("~ update settings of voice %q (%s) to %+v", ch.Name, ch.VoiceID, *ch.Settings)
+	case VoiceChangeDeleteSample:
+		return fmt.Sprintf("- delete sample %s of voice %q (%s)", ch.SampleID, ch.Name, ch.VoiceID)
+	case VoiceChangeDelete:
+		return fmt.Sprintf("- delete voice %q (%s)", ch.Name, ch.VoiceID)
+	}
+	return fmt.Sprintf("? %s voice %q (%s)", ch.Action, ch.Name, ch.VoiceID)
+}
+
+// VoicePlan represents the changes turning a VoiceCatalog into another, returned by PlanVoiceCatalog.
+type VoicePlan struct {
+	Changes []VoiceChange
+}
+
+// String returns the changes of the plan, one per line.
+func (p VoicePlan) String() string {
+	var b strings.Builder
+	for _, ch := range p.Changes {
+		b.WriteString(ch.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// SnapshotVoices exports the voices of the account that can be managed, including their settings and samples.
+// Premade voices, which can't be edited, are left out.
+//
+// The settings of each voice are retrieved with GetVoiceSettings, so it makes one request per voice.
+//
+// It returns a VoiceCatalog or an error.
+func (c *Client) SnapshotVoices() (VoiceCatalog, error) {
+	voices, err := c.GetVoices()
+	if err != nil {
+		return VoiceCatalog{}, err
+	}
+
+	var catalog VoiceCatalog
+	for _, voice := range voices {
+		if voice.Category == "premade" {
+			continue
+		}
+		settings, err := c.GetVoiceSettings(voice.VoiceId)
+		if err != nil {
+			return VoiceCatalog{}, err
+		}
+		spec := VoiceSpec{
+			VoiceID:     voice.VoiceId,
+			Name:        voice.Name,
+			Description: voice.Description,
+			Labels:      voice.Labels,
+			Settings:    &settings,
+			Samples:     []VoiceSampleSpec{},
+		}
+		for _, sample := range voice.Samples {
+			spec.Samples = append(spec.Samples, VoiceSampleSpec{SampleID: sample.SampleId, FileName: sample.FileName, Hash: sample.Hash})
+		}
+		catalog.Voices = append(catalog.Voices, spec)
+	}
+	sort.Slice(catalog.Voices, func(i, j int) bool { return catalog.Voices[i].Name < catalog.Voices[j].Name })
+	return catalog, nil
+}
+
+// PlanVoiceCatalog computes the changes turning the current catalog, as exported by SnapshotVoices, into the
+// desired one. Voices of current missing from desired are deleted. No request is made.
+//
+// It returns the VoicePlan, or an error wrapping ErrInvalidVoiceCatalog if desired can't be applied, e.g.
+// because a new sample has no FilePath.
+func PlanVoiceCatalog(current, desired VoiceCatalog) (VoicePlan, error) {
+	byID := make(map[string]VoiceSpec)
+	byName := make(map[string][]VoiceSpec)
+	for _, voice := range current.Voices {
+		byID[voice.VoiceID] = voice
+		byName[voice.Name] = append(byName[voice.Name], voice)
+	}
+
+	var plan VoicePlan
+	var deletions []VoiceChange
+	kept := make(map[string]bool)
+	for _, want := range desired.Voices {
+		have, found, err := matchVoiceSpec(want, byID, byName)
+		if err != nil {
+			return VoicePlan{}, err
+		}
+		if !found {
+			ch, err := planVoiceCreation(want)
+			if err != nil {
+				return VoicePlan{}, err
+			}
+			plan.Changes = append(plan.Changes, ch)
+			continue
+		}
+		if kept[have.VoiceID] {
+			return VoicePlan{}, fmt.Errorf("%w: voice %s is specified more than once", ErrInvalidVoiceCatalog, have.VoiceID)
+		}
+		kept[have.VoiceID] = true
+		changes, err := planVoiceUpdate(have, want)
+		if err != nil {
+			return VoicePlan{}, err
+		}
+		for _, ch := range changes {
+			if ch.Action == VoiceChangeDeleteSample {
+				deletions = append(deletions, ch)
+			} else {
+				plan.Changes = append(plan.Changes, ch)
+			}
+		}
+	}
+
+	// Samples and voices are deleted last, so that a failed upload doesn't leave a voice without samples.
+	plan.Changes = append(plan.Changes, deletions...)
+	for _, voice := range current.Voices {
+		if !kept[voice.VoiceID] {
+			plan.Changes = append(plan.Changes, VoiceChange{Action: VoiceChangeDelete, VoiceID: voice.VoiceID, Name: voice.Name})
+		}
+	}
+	return plan, nil
+}
+
+func matchVoiceSpec(want VoiceSpec, byID map[string]VoiceSpec, byName map[string][]VoiceSpec) (VoiceSpec, bool, error) {
+	if want.VoiceID != "" {
+		have, ok := byID[want.VoiceID]
+		if !ok {
+			return VoiceSpec{}, false, fmt.Errorf("%w: voice %s doesn't exist", ErrInvalidVoiceCatalog, want.VoiceID)
+		}
+		return have, true, nil
+	}
+	switch matches := byName[want.Name]; len(matches) {
+	case 0:
+		return VoiceSpec{}, false, nil
+	case 1:
+		return matches[0], true, nil
+	}
+	return VoiceSpec{}, false, fmt.Errorf("%w: several voices are named %q, set the voice ID", ErrInvalidVoiceCatalog, want.Name)
+}
+
+func planVoiceCreation(want VoiceSpec) (VoiceChange, error) {
+	req := &AddEditVoiceRequest{Name: want.Name, Description: want.Description, Labels: want.Labels}
+	for _, sample := range want.Samples {
+		if sample.FilePath == "" {
+			return VoiceChange{}, fmt.Errorf("%w: sample %q of new voice %q has no file path", ErrInvalidVoiceCatalog, sample.FileName, want.Name)
+		}
+		req.FilePaths = append(req.FilePaths, sample.FilePath)
+	}
+	if len(req.FilePaths) == 0 {
+		return VoiceChange{}, fmt.Errorf("%w: new voice %q has no samples", ErrInvalidVoiceCatalog, want.Name)
+	}
+	return VoiceChange{Action: VoiceChangeCreate, Name: want.Name, Request: req, Settings: want.Settings}, nil
+}
+
+func planVoiceUpdate(have, want VoiceSpec) ([]VoiceChange, error) {
+	var changes []VoiceChange
+	// The description and labels are sent even if empty, as EditVoice would otherwise leave them unchanged.
+	req := &AddEditVoiceRequest{Name: want.Name, Description: want.Description, Labels: want.Labels, ClearEmpty: true}
+
+	var deletions []VoiceChange
+	if want.Samples != nil {
+		wanted := make(map[string]bool)
+		for _, sample := range want.Samples {
+			if sample.Hash != "" {
+				wanted[sample.Hash] = true
+			}
+		}
+		existing := make(map[string]bool)
+		existingNames := make(map[string]bool)
+		for _, sample := range have.Samples {
+			existing[sample.Hash] = true
+			existingNames[sample.FileName] = true
+			if sample.Hash == "" || !wanted[sample.Hash] {
+				deletions = append(deletions, VoiceChange{Action: VoiceChangeDeleteSample, VoiceID: have.VoiceID, Name: want.Name, SampleID: sample.SampleID})
+			}
+		}
+		for _, sample := range want.Samples {
+			if sample.Hash != "" && existing[sample.Hash] {
+				continue
+			}
+			if sample.FilePath == "" {
+				return nil, fmt.Errorf("%w: sample %q of voice %q doesn't exist and has no file path", ErrInvalidVoiceCatalog, sample.FileName, want.Name)
+			}
+			fileName := sample.FileName
+			if fileName == "" {
+				fileName = filepath.Base(sample.FilePath)
+			}
+			if sample.Hash == "" && existingNames[fileName] {
+				return nil, fmt.Errorf("%w: sample %q of voice %q already exists, set its hash from a new snapshot", ErrInvalidVoiceCatalog, fileName, want.Name)
+			}
+			req.FilePaths = append(req.FilePaths, sample.FilePath)
+		}
+	}
+
+	if want.Name != have.Name || want.Description != have.Description || !equalLabels(want.Labels, have.Labels) || len(req.FilePaths) > 0 {
+		changes = append(changes, VoiceChange{Action: VoiceChangeUpdate, VoiceID: have.VoiceID, Name: want.Name, Request: req})
+	}
+	if want.Settings != nil && (have.Settings == nil || *want.Settings != *have.Settings) {
+		changes = append(changes, VoiceChange{Action: VoiceChangeUpdateSettings, VoiceID: have.VoiceID, Name: want.Name, Settings: want.Settings})
+	}
+	return append(changes, deletions...), nil
+}
+
+func equalLabels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyVoicePlan applies the changes of plan in order, writing each one to log, if not nil, before it is applied.
+// If dryRun is true, the changes are only written to log.
+//
+// It returns nil if every change was applied, or the error of the first change that failed. Changes before it
+// remain applied, so a new plan should be computed from a new snapshot before trying again.
+func (c *Client) ApplyVoicePlan(plan VoicePlan, dryRun bool, log io.Writer) error {
+	for _, ch := range plan.Changes {
+		if log != nil {
+			if _, err := fmt.Fprintln(log, ch); err != nil {
+				return err
+			}
+		}
+		if dryRun {
+			continue
+		}
+		if err := c.applyVoiceChange(ch); err != nil {
+			return fmt.Errorf("failed to %s voice %q: %w", strings.ReplaceAll(ch.Action, "_", " "), ch.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) applyVoiceChange(ch VoiceChange) error {
+	switch ch.Action {
+	case VoiceChangeCreate:
+		voiceID, err := c.AddVoice(*ch.Request)
+		if err != nil || ch.Settings == nil {
+			return err
+		}
+		return c.EditVoiceSettings(voiceID, *ch.Settings)
+	case VoiceChangeUpdate:
+		return c.EditVoice(ch.VoiceID, *ch.Request)
+	case VoiceChangeUpdateSettings:
+		return c.EditVoiceSettings(ch.VoiceID, *ch.Settings)
+	case VoiceChangeDeleteSample:
+		return c.DeleteSample(ch.VoiceID, ch.SampleID)
+	case VoiceChangeDelete:
+		return c.DeleteVoice(ch.VoiceID)
+	}
+	return fmt.Errorf("unknown action %q", ch.Action)
+}
+
+// WriteVoiceCatalog writes catalog to w as a Go source file of package pkg, declaring it as the variable
+// varName. The file can be edited to describe the desired catalog and compiled into the program applying it.
+func WriteVoiceCatalog(w io.Writer, pkg, varName string, catalog VoiceCatalog) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Snapshot of the voice catalog written by elevenlabs.WriteVoiceCatalog.\n\npackage %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/hoshii-ai/elevenlabs-go\"\n\nvar %s = elevenlabs.VoiceCatalog{\nVoices: []elevenlabs.VoiceSpec{\n", varName)
+	for _, voice := range catalog.Voices {
+		b.WriteString("{\n")
+		writeGoField(&b, "VoiceID", voice.VoiceID, "\n")
+		writeGoField(&b, "Name", voice.Name, "\n")
+		writeGoField(&b, "Description", voice.Description, "\n")
+		if len(voice.Labels) > 0 {
+			keys := make([]string, 0, len(voice.Labels))
+			for k := range voice.Labels {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			b.WriteString("Labels: map[string]string{\n")
+			for _, k := range keys {
+				fmt.Fprintf(&b, "%s: %s,\n", strconv.Quote(k), strconv.Quote(voice.Labels[k]))
+			}
+			b.WriteString("},\n")
+		}
+		if s := voice.Settings; s != nil {
+			fmt.Fprintf(&b, "Settings: &elevenlabs.VoiceSettings{SimilarityBoost: %v, Stability: %v, Style: %v, SpeakerBoost: %v},\n",
+				s.SimilarityBoost, s.Stability, s.Style, s.SpeakerBoost)
+		}
+		if voice.Samples != nil {
+			b.WriteString("Samples: []elevenlabs.VoiceSampleSpec{\n")
+			for _, sample := range voice.Samples {
+				b.WriteString("{")
+				writeGoField(&b, "SampleID", sample.SampleID, " ")
+				writeGoField(&b, "FileName", sample.FileName, " ")
+				writeGoField(&b, "Hash", sample.Hash, " ")
+				writeGoField(&b, "FilePath", sample.FilePath, " ")
+				b.WriteString("},\n")
+			}
+			b.WriteString("},\n")
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("},\n}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// writeGoField writes a string field of a composite literal followed by sep, unless the value is empty.
+func writeGoField(b *bytes.Buffer, name, value, sep string) {
+	if value != "" {
+		fmt.Fprintf(b, "%s: %s,%s", name, strconv.Quote(value), sep)
+	}
+}
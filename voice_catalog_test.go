@@ -0,0 +1,262 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestVoiceCatalogPlanApply(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		requests = append(requests, route)
+		switch route {
+		case "GET /voices":
+			json.NewEncoder(w).Encode(map[string]interface{}{"voices": []map[string]interface{}{
+				{"voice_id": "premade1", "name": "Rachel", "category": "premade"},
+				{"voice_id": "v1", "name": "Narrator", "category": "cloned", "labels": map[string]string{"accent": "british"}, "samples": []map[string]string{
+					{"sample_id": "s1", "file_name": "old.mp3", "hash": "h1"},
+					{"sample_id": "s2", "file_name": "keep.mp3", "hash": "h2"},
+				}},
+				{"voice_id": "v2", "name": "Retired", "category": "cloned"},
+			}})
+		case "GET /voices/v1/settings", "GET /voices/v2/settings":
+			w.Write([]byte(`{"stability": 0.5, "similarity_boost": 0.75}`))
+		case "POST /voices/v1/edit":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse form: %v", err)
+				return
+			}
+			if r.FormValue("name") != "Narrator" || r.FormValue("description") != "Audiobooks" || len(r.MultipartForm.File["files"]) != 1 {
+				t.Errorf("Server: unexpected edit form %v", r.MultipartForm)
+			}
+		case "POST /voices/add":
+			w.Write([]byte(`{"voice_id": "v3"}`))
+		case "POST /voices/v1/settings/edit", "POST /voices/v3/settings/edit":
+			var settings elevenlabs.VoiceSettings
+			json.NewDecoder(r.Body).Decode(&settings)
+			if settings.Stability != 0.7 {
+				t.Errorf("Server: unexpected settings %+v", settings)
+			}
+		case "DELETE /voices/v1/samples/s1", "DELETE /voices/v2":
+		default:
+			t.Errorf("Server: unexpected request %s", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	current, err := client.SnapshotVoices()
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if len(current.Voices) != 2 || current.Voices[0].Name != "Narrator" || current.Voices[0].Samples[1].Hash != "h2" || current.Voices[0].Settings.SimilarityBoost != 0.75 {
+		t.Fatalf("Unexpected snapshot %+v", current)
+	}
+
+	path := filepath.Join(t.TempDir(), "new.mp3")
+	if err := os.WriteFile(path, []byte("sample"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	settings := elevenlabs.VoiceSettings{Stability: 0.7, SimilarityBoost: 0.75}
+	desired := elevenlabs.VoiceCatalog{Voices: []elevenlabs.VoiceSpec{
+		{
+			VoiceID:     "v1",
+			Name:        "Narrator",
+			Description: "Audiobooks",
+			Labels:      map[string]string{"accent": "british"},
+			Settings:    &settings,
+			Samples:     []elevenlabs.VoiceSampleSpec{{Hash: "h2"}, {FileName: "new.mp3", FilePath: path}},
+		},
+		{Name: "Announcer", Settings: &settings, Samples: []elevenlabs.VoiceSampleSpec{{FilePath: path}}},
+	}}
+	plan, err := elevenlabs.PlanVoiceCatalog(current, desired)
+	if err != nil {
+		t.Fatalf("Expected no errors planning, got error: %q", err)
+	}
+
+	var log bytes.Buffer
+	requests = nil
+	if err := client.ApplyVoicePlan(plan, true, &log); err != nil {
+		t.Fatalf("Expected no errors in dry run, got error: %q", err)
+	}
+	expLog := `~ update voice "Narrator" (v1), adding 1 samples
+~ update settings of voice "Narrator" (v1) to {SimilarityBoost:0.75 Stability:0.7 Style:0 SpeakerBoost:false}
++ create voice "Announcer" with 1 samples
+- delete sample s1 of voice "Narrator" (v1)
+- delete voice "Retired" (v2)
+`
+	if log.String() != expLog || plan.String() != expLog {
+		t.Errorf("Expected dry run output:\n%s\ngot:\n%s", expLog, log.String())
+	}
+	if len(requests) != 0 {
+		t.Errorf("Expected no requests in dry run, got %q", requests)
+	}
+
+	if err := client.ApplyVoicePlan(plan, false, nil); err != nil {
+		t.Fatalf("Expected no errors applying plan, got error: %q", err)
+	}
+	expRequests := []string{
+		"POST /voices/v1/edit",
+		"POST /voices/v1/settings/edit",
+		"POST /voices/add",
+		"POST /voices/v3/settings/edit",
+		"DELETE /voices/v1/samples/s1",
+		"DELETE /voices/v2",
+	}
+	if !reflect.DeepEqual(requests, expRequests) {
+		t.Errorf("Expected requests %q, got %q", expRequests, requests)
+	}
+
+	// The snapshot is its own desired state.
+	if plan, err := elevenlabs.PlanVoiceCatalog(current, current); err != nil || len(plan.Changes) != 0 {
+		t.Errorf("Expected empty plan, got %v, %v", plan, err)
+	}
+}
+
+func TestVoiceCatalogClear(t *testing.T) {
+	voice := map[string]interface{}{
+		"voice_id": "v1", "name": "Narrator", "category": "cloned", "description": "Audiobooks", "labels": map[string]string{"accent": "british"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch route := r.Method + " " + r.URL.Path; route {
+		case "GET /voices":
+			json.NewEncoder(w).Encode(map[string]interface{}{"voices": []map[string]interface{}{voice}})
+		case "GET /voices/v1/settings":
+			w.Write([]byte(`{"stability": 0.5, "similarity_boost": 0.75}`))
+		case "POST /voices/v1/edit":
+			// Like the API, fields that aren't sent are left unchanged.
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("Server: failed to parse form: %v", err)
+				return
+			}
+			if values, ok := r.MultipartForm.Value["description"]; ok {
+				voice["description"] = values[0]
+			}
+			if values, ok := r.MultipartForm.Value["labels"]; ok {
+				var labels map[string]string
+				if err := json.Unmarshal([]byte(values[0]), &labels); err != nil {
+					t.Errorf("Server: invalid labels %q", values[0])
+				}
+				voice["labels"] = labels
+			}
+		default:
+			t.Errorf("Server: unexpected request %s", route)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	desired := elevenlabs.VoiceCatalog{Voices: []elevenlabs.VoiceSpec{{VoiceID: "v1", Name: "Narrator"}}}
+	for i := 0; i < 2; i++ {
+		current, err := client.SnapshotVoices()
+		if err != nil {
+			t.Fatalf("Expected no errors, got error: %q", err)
+		}
+		plan, err := elevenlabs.PlanVoiceCatalog(current, desired)
+		if err != nil {
+			t.Fatalf("Expected no errors planning, got error: %q", err)
+		}
+		if i == 1 {
+			if len(plan.Changes) != 0 {
+				t.Errorf("Expected empty plan after clearing the description and labels, got:\n%s", plan)
+			}
+			break
+		}
+		if len(plan.Changes) != 1 {
+			t.Fatalf("Expected a single update, got:\n%s", plan)
+		}
+		if err := client.ApplyVoicePlan(plan, false, nil); err != nil {
+			t.Fatalf("Expected no errors applying plan, got error: %q", err)
+		}
+	}
+}
+
+func TestPlanVoiceCatalogInvalid(t *testing.T) {
+	current := elevenlabs.VoiceCatalog{Voices: []elevenlabs.VoiceSpec{{VoiceID: "v1", Name: "Narrator", Samples: []elevenlabs.VoiceSampleSpec{
+		{SampleID: "s1", FileName: "intro.mp3", Hash: "h1"},
+	}}}}
+	for name, desired := range map[string]elevenlabs.VoiceCatalog{
+		"unknown voice ID":          {Voices: []elevenlabs.VoiceSpec{{VoiceID: "v9", Name: "Ghost"}}},
+		"new sample without path":   {Voices: []elevenlabs.VoiceSpec{{VoiceID: "v1", Name: "Narrator", Samples: []elevenlabs.VoiceSampleSpec{{Hash: "h9"}}}}},
+		"new voice without samples": {Voices: []elevenlabs.VoiceSpec{{Name: "Announcer"}}},
+		"uploaded sample without hash": {Voices: []elevenlabs.VoiceSpec{{VoiceID: "v1", Name: "Narrator", Samples: []elevenlabs.VoiceSampleSpec{
+			{FilePath: "/samples/intro.mp3"},
+		}}}},
+	} {
+		if _, err := elevenlabs.PlanVoiceCatalog(current, desired); !errors.Is(err, elevenlabs.ErrInvalidVoiceCatalog) {
+			t.Errorf("%s: expected ErrInvalidVoiceCatalog, got %v", name, err)
+		}
+	}
+}
+
+func TestWriteVoiceCatalog(t *testing.T) {
+	settings := elevenlabs.VoiceSettings{Stability: 0.5, SimilarityBoost: 0.75, SpeakerBoost: true}
+	catalog := elevenlabs.VoiceCatalog{Voices: []elevenlabs.VoiceSpec{{
+		VoiceID:  "v1",
+		Name:     `The "Narrator"`,
+		Labels:   map[string]string{"use_case": "narration", "accent": "british"},
+		Settings: &settings,
+		Samples:  []elevenlabs.VoiceSampleSpec{{SampleID: "s1", FileName: "a.mp3", Hash: "h1"}},
+	}}}
+
+	var b bytes.Buffer
+	if err := elevenlabs.WriteVoiceCatalog(&b, "voices", "Catalog", catalog); err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "catalog.go", b.Bytes(), 0); err != nil {
+		t.Fatalf("Expected valid Go source, got error: %q\n%s", err, b.String())
+	}
+	for _, exp := range []string{
+		"package voices",
+		"var Catalog = elevenlabs.VoiceCatalog{",
+		`"The \"Narrator\"",`,
+		"Settings: &elevenlabs.VoiceSettings{SimilarityBoost: 0.75, Stability: 0.5, Style: 0, SpeakerBoost: true},",
+		`{SampleID: "s1", FileName: "a.mp3", Hash: "h1"},`,
+	} {
+		if !strings.Contains(b.String(), exp) {
+			t.Errorf("Expected output to contain %q, got:\n%s", exp, b.String())
+		}
+	}
+	if strings.Index(b.String(), `"accent"`) > strings.Index(b.String(), `"use_case"`) {
+		t.Errorf("Expected labels to be sorted, got:\n%s", b.String())
+	}
+}
+
+func TestPlanVoiceCatalogSamplesWithoutHash(t *testing.T) {
+	current := elevenlabs.VoiceCatalog{Voices: []elevenlabs.VoiceSpec{{VoiceID: "v1", Name: "Narrator", Samples: []elevenlabs.VoiceSampleSpec{
+		{SampleID: "s1", FileName: "intro.mp3", Hash: "h1"},
+		{SampleID: "s2", FileName: "unhashed.mp3"},
+	}}}}
+	desired := elevenlabs.VoiceCatalog{Voices: []elevenlabs.VoiceSpec{{VoiceID: "v1", Name: "Narrator", Samples: []elevenlabs.VoiceSampleSpec{
+		{Hash: "h1"},
+		{FilePath: "/samples/outro.mp3"},
+	}}}}
+
+	// The existing sample without a hash isn't kept by the new sample without one.
+	plan, err := elevenlabs.PlanVoiceCatalog(current, desired)
+	if err != nil {
+		t.Fatalf("Expected no errors planning, got error: %q", err)
+	}
+	expPlan := `~ update voice "Narrator" (v1), adding 1 samples
+- delete sample s2 of voice "Narrator" (v1)
+`
+	if plan.String() != expPlan {
+		t.Errorf("Expected plan:\n%s\ngot:\n%s", expPlan, plan)
+	}
+}
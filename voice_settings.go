@@ -0,0 +1,87 @@
+package elevenlabs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Names of the presets returned by VoiceSettingsPreset.
+const (
+	VoiceSettingsPresetNarration      = "narration"      // Steady delivery for long-form content such as audiobooks
+	VoiceSettingsPresetConversational = "conversational" // Natural variation for dialogue and agents
+	VoiceSettingsPresetExpressive     = "expressive"     // Emotional range for characters, at the cost of consistency
+)
+
+var voiceSettingsPresets = map[string]VoiceSettings{
+	VoiceSettingsPresetNarration:      {Stability: 0.7, SimilarityBoost: 0.75, SpeakerBoost: true},
+	VoiceSettingsPresetConversational: {Stability: 0.45, SimilarityBoost: 0.75, Style: 0.1, SpeakerBoost: true},
+	VoiceSettingsPresetExpressive:     {Stability: 0.3, SimilarityBoost: 0.8, Style: 0.6, SpeakerBoost: true},
+}
+
+// VoiceSettingsPreset returns the settings of the preset with the given name, one of the VoiceSettingsPreset
+// constants. It returns false if there is no such preset.
+//
+// Presets use style and speaker boost, which not all models support. Use Validate to check them against the
+// model used.
+func VoiceSettingsPreset(name string) (VoiceSettings, bool) {
+	settings, ok := voiceSettingsPresets[name]
+	return settings, ok
+}
+
+// PartialVoiceSettings represents the voice settings to change in VoiceSettings.Merge. Nil fields are left
+// unchanged.
+type PartialVoiceSettings struct {
+	SimilarityBoost *float32
+	Stability       *float32
+	Style           *float32
+	SpeakerBoost    *bool
+}
+
+// Merge returns the settings with the non-nil fields of partial overlaid, e.g. to change the settings returned
+// by GetVoiceSettings or GetDefaultVoiceSettings before saving them with EditVoiceSettings.
+func (s VoiceSettings) Merge(partial PartialVoiceSettings) VoiceSettings {
+	if partial.SimilarityBoost != nil {
+		s.SimilarityBoost = *partial.SimilarityBoost
+	}
+	if partial.Stability != nil {
+		s.Stability = *partial.Stability
+	}
+	if partial.Style != nil {
+		s.Style = *partial.Style
+	}
+	if partial.SpeakerBoost != nil {
+		s.SpeakerBoost = *partial.SpeakerBoost
+	}
+	return s
+}
+
+// Validate checks the settings locally, so that they don't fail with a ValidationError once sent. Stability,
+// similarity boost and style must be between 0 and 1. If model isn't nil, style and speaker boost are only
+// allowed if the model supports them, as reported by Model.CanUseStyle and Model.CanUseSpeakerBoost.
+//
+// It returns an error wrapping ErrInvalidVoiceSettings that lists every problem found, or nil if there is none.
+func (s VoiceSettings) Validate(model *Model) error {
+	var problems []string
+	for _, field := range []struct {
+		name  string
+		value float32
+	}{
+		{"stability", s.Stability},
+		{"similarity_boost", s.SimilarityBoost},
+		{"style", s.Style},
+	} {
+		if !(field.value >= 0 && field.value <= 1) {
+			problems = append(problems, fmt.Sprintf("%s %g is out of range [0, 1]", field.name, field.value))
+		}
+	}
+	if model != nil && s.Style != 0 && !model.CanUseStyle {
+		problems = append(problems, fmt.Sprintf("model %q doesn't support style", model.ModelId))
+	}
+	if model != nil && s.SpeakerBoost && !model.CanUseSpeakerBoost {
+		problems = append(problems, fmt.Sprintf("model %q doesn't support speaker boost", model.ModelId))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidVoiceSettings, strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package elevenlabs_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+func TestVoiceSettingsValidate(t *testing.T) {
+	model := &elevenlabs.Model{ModelId: "eleven_flash_v2_5", CanUseSpeakerBoost: true}
+	for _, tc := range []struct {
+		name     string
+		settings elevenlabs.VoiceSettings
+		model    *elevenlabs.Model
+		problems []string
+	}{
+		{name: "valid", settings: elevenlabs.VoiceSettings{Stability: 0.5, SimilarityBoost: 1, SpeakerBoost: true}, model: model},
+		{name: "out of range", settings: elevenlabs.VoiceSettings{Stability: 1.5, SimilarityBoost: -0.1}, problems: []string{"stability 1.5", "similarity_boost -0.1"}},
+		{name: "not a number", settings: elevenlabs.VoiceSettings{Stability: float32(math.NaN())}, problems: []string{"stability NaN"}},
+		{name: "style unsupported", settings: elevenlabs.VoiceSettings{Style: 0.3}, model: model, problems: []string{"doesn't support style"}},
+		{name: "capabilities unchecked without model", settings: elevenlabs.VoiceSettings{Style: 0.3, SpeakerBoost: true}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settings.Validate(tc.model)
+			if len(tc.problems) == 0 {
+				if err != nil {
+					t.Errorf("Expected no errors, got error: %q", err)
+				}
+				return
+			}
+			if !errors.Is(err, elevenlabs.ErrInvalidVoiceSettings) {
+				t.Fatalf("Expected ErrInvalidVoiceSettings, got %v", err)
+			}
+			for _, problem := range tc.problems {
+				if !strings.Contains(err.Error(), problem) {
+					t.Errorf("Expected error to mention %q, got %q", problem, err)
+				}
+			}
+		})
+	}
+}
+
+func TestVoiceSettingsPresetMerge(t *testing.T) {
+	preset, ok := elevenlabs.VoiceSettingsPreset(elevenlabs.VoiceSettingsPresetNarration)
+	if !ok {
+		t.Fatalf("Expected narration preset to exist")
+	}
+	if _, ok := elevenlabs.VoiceSettingsPreset("whisper"); ok {
+		t.Errorf("Expected unknown preset not to exist")
+	}
+
+	stability, speakerBoost := float32(0.2), false
+	merged := preset.Merge(elevenlabs.PartialVoiceSettings{Stability: &stability, SpeakerBoost: &speakerBoost})
+	exp := elevenlabs.VoiceSettings{Stability: 0.2, SimilarityBoost: preset.SimilarityBoost, Style: preset.Style}
+	if merged != exp {
+		t.Errorf("Expected merged settings %+v, got %+v", exp, merged)
+	}
+	if err := merged.Validate(nil); err != nil {
+		t.Errorf("Expected merged settings to be valid, got error: %q", err)
+	}
+}
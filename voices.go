@@ -0,0 +1,47 @@
+package elevenlabs
+
+import "context"
+
+// VoiceIterator walks the voices matching a VoiceSearchFilter, fetching pages lazily.
+//
+//	it := client.IterateVoices(ctx, elevenlabs.VoiceSearchFilter{Category: "cloned"})
+//	for it.Next() {
+//		voice := it.Voice()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type VoiceIterator struct {
+	pageIterator[Voice]
+}
+
+// Voice returns the current voice. It must only be called after Next returned true.
+func (it *VoiceIterator) Voice() Voice {
+	return it.cur
+}
+
+// IterateVoices returns an iterator over the voices matching filter.
+//
+// It takes a context.Context argument that bounds the whole iteration, and a VoiceSearchFilter argument. Pages
+// are only fetched as the iterator advances, so stopping early saves API calls.
+func (c *Client) IterateVoices(ctx context.Context, filter VoiceSearchFilter) *VoiceIterator {
+	queries := filter.queries()
+	it := &VoiceIterator{}
+	it.ctx = ctx
+	it.fetch = func(ctx context.Context, token string) ([]Voice, string, error) {
+		pageQueries := queries
+		if token != "" {
+			pageQueries = nextPageQueries(queries, nextPageToken(token))
+		}
+		voicesResp, err := c.searchVoices(ctx, pageQueries)
+		if err != nil {
+			return nil, "", err
+		}
+		if !voicesResp.HasMore {
+			return voicesResp.Voices, "", nil
+		}
+		return voicesResp.Voices, voicesResp.NextPageToken, nil
+	}
+	return it
+}
@@ -0,0 +1,99 @@
+package elevenlabs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+// testVoicesServer serves three pages of voices from the paginated voices search endpoint.
+func testVoicesServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	pages := map[string]map[string]interface{}{
+		"":   {"voices": []map[string]string{{"voice_id": "v1"}, {"voice_id": "v2"}}, "has_more": true, "next_page_token": "p2", "total_count": 5},
+		"p2": {"voices": []map[string]string{{"voice_id": "v3"}, {"voice_id": "v4"}}, "has_more": true, "next_page_token": "p3", "total_count": 5},
+		"p3": {"voices": []map[string]string{{"voice_id": "v5"}}, "has_more": false, "total_count": 5},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.URL.Path != "/v2/voices" {
+			t.Errorf("Server: unexpected path %q", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("search") != "narrator" || q.Get("category") != "cloned" || q.Get("voice_type") != elevenlabs.VoiceTypePersonal ||
+			q.Get("sort") != elevenlabs.VoiceSortName || q.Get("sort_direction") != "asc" || q.Get("page_size") != "2" {
+			t.Errorf("Server: unexpected query string %q", r.URL.RawQuery)
+		}
+		page, ok := pages[q.Get("next_page_token")]
+		if !ok {
+			t.Errorf("Server: unexpected page token %q", q.Get("next_page_token"))
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+}
+
+var testVoiceSearchFilter = elevenlabs.VoiceSearchFilter{
+	Search:        "narrator",
+	Category:      "cloned",
+	VoiceType:     elevenlabs.VoiceTypePersonal,
+	Sort:          elevenlabs.VoiceSortName,
+	SortDirection: "asc",
+	PageSize:      2,
+}
+
+func TestSearchVoices(t *testing.T) {
+	var requests int
+	server := testVoicesServer(t, &requests)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	resp, nextPage, err := client.SearchVoices(testVoiceSearchFilter)
+	if err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if len(resp.Voices) != 2 || resp.TotalCount != 5 || nextPage == nil {
+		t.Fatalf("Unexpected first page: %+v", resp)
+	}
+	if resp, nextPage, err = nextPage(); err != nil || resp.Voices[0].VoiceId != "v3" || nextPage == nil {
+		t.Fatalf("Unexpected second page: %+v, %v", resp, err)
+	}
+	if resp, nextPage, err = nextPage(); err != nil || resp.Voices[0].VoiceId != "v5" || nextPage != nil {
+		t.Fatalf("Unexpected last page: %+v, %v", resp, err)
+	}
+}
+
+func TestIterateVoices(t *testing.T) {
+	var requests int
+	server := testVoicesServer(t, &requests)
+	defer server.Close()
+	client := elevenlabs.NewMockClient(context.Background(), server.URL, mockAPIKey, mockTimeout)
+
+	var ids []string
+	it := client.IterateVoices(context.Background(), testVoiceSearchFilter)
+	for it.Next() {
+		ids = append(ids, it.Voice().VoiceId)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Expected no errors, got error: %q", err)
+	}
+	if !reflect.DeepEqual(ids, []string{"v1", "v2", "v3", "v4", "v5"}) || requests != 3 {
+		t.Errorf("Expected 5 voices in 3 requests, got %v in %d", ids, requests)
+	}
+
+	// Pages are only fetched when needed, and no more once the context is cancelled.
+	requests = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	it = client.IterateVoices(ctx, testVoiceSearchFilter)
+	for i := 0; i < 2 && it.Next(); i++ {
+	}
+	cancel()
+	if it.Next() || !errors.Is(it.Err(), context.Canceled) || requests != 1 {
+		t.Errorf("Expected cancelled iteration to stop with context.Canceled after 1 request, got %v after %d", it.Err(), requests)
+	}
+}
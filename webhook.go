@@ -0,0 +1,386 @@
+package elevenlabs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	webhookSignatureHeader    = "ElevenLabs-Signature"
+	defaultWebhookTolerance   = 30 * time.Minute
+	defaultWebhookMaxBodySize = 64 << 20
+	webhookAudioField         = "full_audio"
+	webhookAudioDecodeChunkSz = 32 << 10
+)
+
+// WebhookIdempotencyStore records the webhook events that have been handled, so that events delivered more than
+// once are only dispatched once. Implementations backed by a shared database allow several replicas of a service
+// to share the work.
+type WebhookIdempotencyStore interface {
+	// Claim marks key as being handled. It returns false if key was already claimed.
+	Claim(key string) (bool, error)
+	// Release forgets a claimed key, after its callback failed, so that the retried event is handled again.
+	Release(key string) error
+}
+
+// PostCallWebhookConfig represents the settings of a PostCallWebhookHandler. Events of types without a callback
+// are acknowledged and ignored.
+type PostCallWebhookConfig struct {
+	// Secret is the HMAC secret of the webhook, shown when it is created in the ElevenLabs dashboard. It is
+	// required: without it, every request is answered with 500 Internal Server Error and no event is dispatched.
+	Secret string
+	// OnTranscription is called with the transcript and analysis of each conversation.
+	OnTranscription func(ctx context.Context, event PostCallTranscriptionEvent) error
+	// OnAudio is called with the recording of each conversation, an MP3 file, which is read from a temporary file
+	// rather than kept in memory.
+	OnAudio func(ctx context.Context, event PostCallAudioEvent, audio io.Reader) error
+	// OnCallInitiationFailure is called for each outbound call that couldn't be connected.
+	OnCallInitiationFailure func(ctx context.Context, event CallInitiationFailureEvent) error
+	// Store records the events handled. It defaults to an in-memory store.
+	Store WebhookIdempotencyStore
+	// Tolerance is the maximum difference between the signature timestamp of an event and the current time, in
+	// either direction. It defaults to 30 minutes.
+	Tolerance time.Duration
+	// MaxBodySize is the maximum size of an event in bytes, recording included. Larger requests are rejected with
+	// 413 Request Entity Too Large. It defaults to 64 MiB.
+	MaxBodySize int64
+	// TempDir is the directory where recordings are spooled. It defaults to os.TempDir.
+	TempDir string
+}
+
+// PostCallWebhookHandler is an http.Handler receiving the post-call webhooks of conversational AI agents.
+//
+// The signature of each event is verified against the webhook secret before its callback is called. Events are
+// dispatched once per type and conversation ID: repeated deliveries are acknowledged without calling the callback
+// again, unless it returned an error, in which case the handler responds with 500 Internal Server Error so that
+// the event is retried.
+type PostCallWebhookHandler struct {
+	config PostCallWebhookConfig
+}
+
+// NewPostCallWebhookHandler returns a PostCallWebhookHandler dispatching events to the callbacks of config.
+func NewPostCallWebhookHandler(config PostCallWebhookConfig) *PostCallWebhookHandler {
+	if config.Store == nil {
+		config.Store = &memoryIdempotencyStore{keys: map[string]bool{}}
+	}
+	if config.Tolerance <= 0 {
+		config.Tolerance = defaultWebhookTolerance
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = defaultWebhookMaxBodySize
+	}
+	return &PostCallWebhookHandler{config: config}
+}
+
+type webhookEnvelope struct {
+	Type string `json:"type"`
+	Data struct {
+		ConversationID string `json:"conversation_id"`
+	} `json:"data"`
+}
+
+func (h *PostCallWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if h.config.Secret == "" {
+		// Anyone could sign events with an empty secret.
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	timestamp, signature, err := parseWebhookSignature(r.Header.Get(webhookSignatureHeader))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	if skew := time.Since(time.Unix(timestamp, 0)); skew > h.config.Tolerance || skew < -h.config.Tolerance {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	if r.ContentLength > h.config.MaxBodySize {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// The temporary file is only created once the recording of an audio event is found, and only if it is
+	// handled. Otherwise the recording, like any repeated one, is discarded as it is read. As the signature can
+	// only be checked once the whole body is read, the file is removed as soon as the check fails, and otherwise
+	// once the request is handled.
+	var audio *os.File
+	removeAudio := func() {
+		if audio != nil {
+			audio.Close()
+			os.Remove(audio.Name())
+			audio = nil
+		}
+	}
+	defer removeAudio()
+	openAudio := func() (io.Writer, error) {
+		if h.config.OnAudio == nil || audio != nil {
+			return io.Discard, nil
+		}
+		var err error
+		audio, err = os.CreateTemp(h.config.TempDir, "elevenlabs-webhook-*.mp3")
+		return audio, err
+	}
+
+	// The signature covers the raw body, which is hashed as it is read. The recording, if any, is decoded to the
+	// temporary file on the fly, leaving only the small remainder of the event in memory.
+	mac := hmac.New(sha256.New, []byte(h.config.Secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	read := &countingWriter{}
+	bodyReader := io.TeeReader(http.MaxBytesReader(w, r.Body, h.config.MaxBodySize), io.MultiWriter(mac, read))
+	body, err := spoolWebhookAudio(bodyReader, openAudio)
+	if err != nil && read.n >= h.config.MaxBodySize {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, errWebhookAudioFile) {
+			status = http.StatusInternalServerError
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	if !hmac.Equal(mac.Sum(nil), signature) {
+		removeAudio()
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var envelope webhookEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var dispatch func(ctx context.Context) error
+	switch envelope.Type {
+	case PostCallTranscriptionWebhook:
+		if h.config.OnTranscription == nil {
+			break
+		}
+		var event PostCallTranscriptionEvent
+		err = json.Unmarshal(body, &event)
+		dispatch = func(ctx context.Context) error { return h.config.OnTranscription(ctx, event) }
+	case PostCallAudioWebhook:
+		if h.config.OnAudio == nil {
+			break
+		}
+		var event PostCallAudioEvent
+		err = json.Unmarshal(body, &event)
+		if audio == nil {
+			// The event has no recording.
+			dispatch = func(ctx context.Context) error { return h.config.OnAudio(ctx, event, bytes.NewReader(nil)) }
+			break
+		}
+		dispatch = func(ctx context.Context) error {
+			if _, err := audio.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			return h.config.OnAudio(ctx, event, audio)
+		}
+	case CallInitiationFailureWebhook:
+		if h.config.OnCallInitiationFailure == nil {
+			break
+		}
+		var event CallInitiationFailureEvent
+		err = json.Unmarshal(body, &event)
+		dispatch = func(ctx context.Context) error { return h.config.OnCallInitiationFailure(ctx, event) }
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if dispatch == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	key := envelope.Type + ":" + envelope.Data.ConversationID
+	claimed, err := h.config.Store.Claim(key)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !claimed {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err := dispatch(r.Context()); err != nil {
+		_ = h.config.Store.Release(key)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseWebhookSignature parses a signature header of the form "t=<unix timestamp>,v0=<hex HMAC-SHA256>".
+func parseWebhookSignature(header string) (int64, []byte, error) {
+	var timestamp int64
+	var signature []byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		var err error
+		switch key {
+		case "t":
+			timestamp, err = strconv.ParseInt(value, 10, 64)
+		case "v0":
+			signature, err = hex.DecodeString(value)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	if timestamp == 0 || signature == nil {
+		return 0, nil, errors.New("invalid webhook signature header")
+	}
+	return timestamp, signature, nil
+}
+
+// errWebhookAudioFile is returned by spoolWebhookAudio when the file holding the recording couldn't be created.
+var errWebhookAudioFile = errors.New("failed to create webhook audio file")
+
+// spoolWebhookAudio copies the JSON document read from r, except for the base64 value of its full_audio field
+// which is decoded to the writer returned by openAudio and replaced with an empty string.
+func spoolWebhookAudio(r io.Reader, openAudio func() (io.Writer, error)) ([]byte, error) {
+	br := bufio.NewReader(r)
+	out := bytes.Buffer{}
+	var inString, escaped bool
+	str := bytes.Buffer{} // Content of the string being read, to recognize the audio field name
+	lastString := ""      // Content of the last string read, followed by ':' if it is a field name
+	for {
+		c, err := br.ReadByte()
+		if err == io.EOF {
+			return out.Bytes(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		out.WriteByte(c)
+
+		switch {
+		case inString && !escaped && c == '"':
+			inString = false
+			lastString = str.String()
+		case inString:
+			escaped = !escaped && c == '\\'
+			if str.Len() <= len(webhookAudioField) {
+				str.WriteByte(c)
+			}
+		case c == '"':
+			if lastString == webhookAudioField+":" {
+				audio, err := openAudio()
+				if err != nil {
+					return nil, fmt.Errorf("%w: %v", errWebhookAudioFile, err)
+				}
+				if err := decodeWebhookAudio(br, audio); err != nil {
+					return nil, err
+				}
+				out.WriteByte('"')
+				lastString = ""
+				continue
+			}
+			inString = true
+			str.Reset()
+			lastString = ""
+		case c == ':' && lastString == webhookAudioField:
+			lastString += ":"
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		default:
+			lastString = ""
+		}
+	}
+}
+
+// decodeWebhookAudio decodes a base64 JSON string value from r, up to and including its closing quote.
+func decodeWebhookAudio(r *bufio.Reader, audio io.Writer) error {
+	encoded := make([]byte, 0, webhookAudioDecodeChunkSz)
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(webhookAudioDecodeChunkSz))
+	flush := func(n int) error {
+		m, err := base64.StdEncoding.Decode(decoded, encoded[:n])
+		if err != nil {
+			return err
+		}
+		if _, err := audio.Write(decoded[:m]); err != nil {
+			return err
+		}
+		encoded = append(encoded[:0], encoded[n:]...)
+		return nil
+	}
+
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		switch c {
+		case '"':
+			return flush(len(encoded))
+		case '\\':
+			// Base64 only needs escaping for '/', which some encoders write as "\/".
+			if c, err = r.ReadByte(); err != nil {
+				return err
+			}
+			if c != '/' {
+				return fmt.Errorf("unexpected escape sequence in audio: \\%c", c)
+			}
+		}
+		encoded = append(encoded, c)
+		if len(encoded) == cap(encoded) {
+			if err := flush(len(encoded)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+type memoryIdempotencyStore struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+func (s *memoryIdempotencyStore) Claim(key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.keys[key] {
+		return false, nil
+	}
+	s.keys[key] = true
+	return true, nil
+}
+
+func (s *memoryIdempotencyStore) Release(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+	return nil
+}
@@ -0,0 +1,175 @@
+package elevenlabs_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/elevenlabs-go"
+)
+
+const testWebhookSecret = "wsec_test"
+
+func signedWebhookRequest(body string, timestamp time.Time, secret string) *http.Request {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", timestamp.Unix(), body)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/elevenlabs", strings.NewReader(body))
+	req.Header.Set("ElevenLabs-Signature", fmt.Sprintf("t=%d,v0=%s", timestamp.Unix(), hex.EncodeToString(mac.Sum(nil))))
+	return req
+}
+
+func TestPostCallWebhookHandler(t *testing.T) {
+	audio := bytes.Repeat([]byte("mp3 frame \xff\xfb"), 10000)
+	// Encode slashes as "\/" like some JSON encoders do.
+	audioJSON := strings.ReplaceAll(base64.StdEncoding.EncodeToString(audio), "/", `\/`)
+
+	var transcripts []elevenlabs.PostCallTranscriptionEvent
+	var recordings [][]byte
+	var failures []elevenlabs.CallInitiationFailureEvent
+	failNext := true
+	handler := elevenlabs.NewPostCallWebhookHandler(elevenlabs.PostCallWebhookConfig{
+		Secret: testWebhookSecret,
+		OnTranscription: func(ctx context.Context, e elevenlabs.PostCallTranscriptionEvent) error {
+			transcripts = append(transcripts, e)
+			return nil
+		},
+		OnAudio: func(ctx context.Context, e elevenlabs.PostCallAudioEvent, r io.Reader) error {
+			if failNext {
+				failNext = false
+				return errors.New("storage unavailable")
+			}
+			b, err := io.ReadAll(r)
+			recordings = append(recordings, b)
+			return err
+		},
+		OnCallInitiationFailure: func(ctx context.Context, e elevenlabs.CallInitiationFailureEvent) error {
+			failures = append(failures, e)
+			return nil
+		},
+		TempDir: t.TempDir(),
+	})
+
+	transcription := `{"type": "post_call_transcription", "event_timestamp": 1700000000, "data": ` + string(testRespBodies["TestGetConversation"]) + `}`
+	audioEvent := `{"type": "post_call_audio", "event_timestamp": 1700000000, "data": {"agent_id": "a1", "full_audio" : "` + audioJSON + `", "conversation_id": "conv1"}}`
+	failure := `{"type": "call_initiation_failure", "event_timestamp": 1700000000, "data": {"agent_id": "a1", "conversation_id": "conv2", "failure_reason": "busy", "metadata": {"type": "twilio", "body": {}}}}`
+	now := time.Now()
+
+	testCases := []struct {
+		name      string
+		req       *http.Request
+		expStatus int
+	}{
+		{name: "Transcription", req: signedWebhookRequest(transcription, now, testWebhookSecret), expStatus: http.StatusOK},
+		{name: "Duplicate transcription", req: signedWebhookRequest(transcription, now, testWebhookSecret), expStatus: http.StatusOK},
+		{name: "Audio with failing callback", req: signedWebhookRequest(audioEvent, now, testWebhookSecret), expStatus: http.StatusInternalServerError},
+		{name: "Retried audio", req: signedWebhookRequest(audioEvent, now, testWebhookSecret), expStatus: http.StatusOK},
+		{name: "Call initiation failure", req: signedWebhookRequest(failure, now, testWebhookSecret), expStatus: http.StatusOK},
+		{name: "Unknown type", req: signedWebhookRequest(`{"type": "future_event", "data": {}}`, now, testWebhookSecret), expStatus: http.StatusOK},
+		{name: "Wrong secret", req: signedWebhookRequest(transcription, now, "wsec_other"), expStatus: http.StatusUnauthorized},
+		{name: "Expired signature", req: signedWebhookRequest(transcription, now.Add(-time.Hour), testWebhookSecret), expStatus: http.StatusUnauthorized},
+		{name: "Future signature", req: signedWebhookRequest(transcription, now.Add(time.Hour), testWebhookSecret), expStatus: http.StatusUnauthorized},
+		{name: "Missing signature", req: httptest.NewRequest(http.MethodPost, "/webhooks/elevenlabs", strings.NewReader(transcription)), expStatus: http.StatusUnauthorized},
+		{name: "Malformed body", req: signedWebhookRequest(`{"type": `, now, testWebhookSecret), expStatus: http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, tc.req)
+		if rec.Code != tc.expStatus {
+			t.Errorf("%s: expected status %d, got %d", tc.name, tc.expStatus, rec.Code)
+		}
+	}
+
+	if len(transcripts) != 1 || transcripts[0].Data.ConversationID != "conv1" || transcripts[0].Data.Analysis.CallSuccessful != elevenlabs.EvaluationSuccess {
+		t.Errorf("Expected one transcription event, got %+v", transcripts)
+	}
+	if len(recordings) != 1 || !bytes.Equal(recordings[0], audio) {
+		t.Errorf("Expected one recording of %d bytes, got %d recordings", len(audio), len(recordings))
+	}
+	if len(failures) != 1 || failures[0].Data.FailureReason != "busy" {
+		t.Errorf("Expected one call initiation failure, got %+v", failures)
+	}
+}
+
+func TestPostCallWebhookHandlerLimits(t *testing.T) {
+	dir := t.TempDir()
+	var recordings int
+	handler := elevenlabs.NewPostCallWebhookHandler(elevenlabs.PostCallWebhookConfig{
+		Secret: testWebhookSecret,
+		OnAudio: func(ctx context.Context, e elevenlabs.PostCallAudioEvent, r io.Reader) error {
+			recordings++
+			return nil
+		},
+		MaxBodySize: 1000,
+		TempDir:     dir,
+	})
+
+	audio := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{0xff}, 2000))
+	large := `{"type": "post_call_audio", "data": {"conversation_id": "conv1", "full_audio": "` + audio + `"}}`
+	streamed := signedWebhookRequest(large, time.Now(), testWebhookSecret)
+	streamed.ContentLength = -1 // The size is only discovered while reading.
+	streamed.Body = io.NopCloser(struct{ io.Reader }{strings.NewReader(large)})
+
+	testCases := []struct {
+		name      string
+		req       *http.Request
+		expStatus int
+	}{
+		{name: "Declared oversized body", req: signedWebhookRequest(large, time.Now(), testWebhookSecret), expStatus: http.StatusRequestEntityTooLarge},
+		{name: "Streamed oversized body", req: streamed, expStatus: http.StatusRequestEntityTooLarge},
+		{name: "Event without recording", req: signedWebhookRequest(`{"type": "post_call_transcription", "data": {"conversation_id": "conv1"}}`, time.Now(), testWebhookSecret), expStatus: http.StatusOK},
+		{name: "Forged recording", req: signedWebhookRequest(`{"type": "post_call_audio", "data": {"conversation_id": "conv1", "full_audio": "//v/+w=="}}`, time.Now(), "wsec_other"), expStatus: http.StatusUnauthorized},
+	}
+	for _, tc := range testCases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, tc.req)
+		if rec.Code != tc.expStatus {
+			t.Errorf("%s: expected status %d, got %d", tc.name, tc.expStatus, rec.Code)
+		}
+		if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+			t.Errorf("%s: expected no temporary files left, got %d", tc.name, len(entries))
+		}
+	}
+	if recordings != 0 {
+		t.Errorf("Expected no recordings to be dispatched, got %d", recordings)
+	}
+
+	// Events without a recording don't need a temporary file, so they are handled even if none can be created.
+	handler = elevenlabs.NewPostCallWebhookHandler(elevenlabs.PostCallWebhookConfig{
+		Secret:          testWebhookSecret,
+		OnTranscription: func(ctx context.Context, e elevenlabs.PostCallTranscriptionEvent) error { return nil },
+		OnAudio:         func(ctx context.Context, e elevenlabs.PostCallAudioEvent, r io.Reader) error { return nil },
+		TempDir:         filepath.Join(dir, "missing"),
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedWebhookRequest(`{"type": "post_call_transcription", "data": {"conversation_id": "conv2"}}`, time.Now(), testWebhookSecret))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected transcription to be handled without a temporary file, got status %d", rec.Code)
+	}
+}
+
+func TestPostCallWebhookHandlerWithoutSecret(t *testing.T) {
+	handler := elevenlabs.NewPostCallWebhookHandler(elevenlabs.PostCallWebhookConfig{
+		OnTranscription: func(ctx context.Context, e elevenlabs.PostCallTranscriptionEvent) error {
+			t.Errorf("Expected no events to be dispatched without a secret, got %+v", e)
+			return nil
+		},
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedWebhookRequest(`{"type": "post_call_transcription", "data": {"conversation_id": "conv1"}}`, time.Now(), ""))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
@@ -0,0 +1,66 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// dialWebSocket opens a WebSocket connection to the given API path, deriving the ws/wss URL from the
+// client's base URL so that the same SetBaseURL override used for HTTP calls applies to sockets as well.
+//
+// The default client timeout, if applicable, only bounds the opening handshake and not the lifetime
+// of the connection.
+func (c *Client) dialWebSocket(ctx context.Context, path string, queries ...QueryFunc) (*websocket.Conn, error) {
+	u, err := url.Parse(strings.TrimSuffix(c.baseURL, "/") + path)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+
+	q := u.Query()
+	for _, qf := range queries {
+		qf(&q)
+	}
+	u.RawQuery = q.Encode()
+
+	header := http.Header{}
+	if c.apiKey != "" {
+		header.Add("xi-api-key", c.apiKey)
+	}
+
+	dialCtx := ctx
+	if ctx == c.defaultCtx {
+		ctx_, cancel := context.WithTimeout(ctx, c.defaultTimeout)
+		dialCtx = ctx_
+		defer cancel()
+	}
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(dialCtx, u.String(), header)
+	if err != nil {
+		if errors.Is(err, websocket.ErrBadHandshake) && resp != nil {
+			defer resp.Body.Close()
+			return nil, errorFromResponse(resp)
+		}
+		return nil, fmt.Errorf("failed to open websocket connection: %w", err)
+	}
+	return conn, nil
+}
+
+// isExpectedClose reports whether err is the result of the connection being closed in an orderly
+// fashion, either by the server or by a local call to Close.
+func isExpectedClose(err error) bool {
+	return websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) ||
+		errors.Is(err, net.ErrClosed)
+}